@@ -39,6 +39,9 @@
 package v1alpha1
 
 import (
+	"slices"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -55,11 +58,88 @@ type DataguardBrokerSpec struct {
 	SetAsPrimaryDatabase string            `json:"setAsPrimaryDatabase,omitempty"`
 	LoadBalancer         bool              `json:"loadBalancer,omitempty"`
 	ServiceAnnotations   map[string]string `json:"serviceAnnotations,omitempty"`
-	// +kubebuilder:validation:Enum=MaxPerformance;MaxAvailability
+	// +kubebuilder:validation:Enum=MaxPerformance;MaxAvailability;MaxProtection
 	ProtectionMode string            `json:"protectionMode"`
 	NodeSelector   map[string]string `json:"nodeSelector,omitempty"`
 
 	FastStartFailover bool `json:"fastStartFailover,omitempty"`
+
+	// Number of fast-start failover observers, the broker elects one of them as the master observer
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	Observers         int                               `json:"observers,omitempty"`
+	ObserverPlacement *DataguardBrokerObserverPlacement `json:"observerPlacement,omitempty"`
+
+	// Redo transport and apply properties of the standby databases
+	// +listType=map
+	// +listMapKey=databaseRef
+	StandbySettings []DataguardBrokerStandbySettings `json:"standbySettings,omitempty"`
+
+	// Far sync instance receiving SYNC redo from the primary and forwarding it ASYNC to the standby databases
+	FarSync *DataguardBrokerFarSync `json:"farSync,omitempty"`
+
+	// Interval in seconds between the health checks of the dataguard configuration, the checks are disabled when unset
+	// +kubebuilder:validation:Minimum=10
+	HealthCheckInterval int `json:"healthCheckInterval,omitempty"`
+
+	// Standby databases hosted outside of this kubernetes cluster
+	// +listType=map
+	// +listMapKey=sid
+	ExternalDatabases []DataguardBrokerExternalDatabase `json:"externalDatabases,omitempty"`
+}
+
+// Database hosted in another kubernetes cluster or on a VM, prepared as a physical standby with the broker started
+type DataguardBrokerExternalDatabase struct {
+	// db_unique_name of the external database, used in setAsPrimaryDatabase for a switchover to it
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]{0,29}$`
+	Sid string `json:"sid"`
+	// Connect string the broker uses to reach the external database, for example host:1521/SID
+	ConnectString string                                  `json:"connectString"`
+	AdminPassword DataguardBrokerExternalDatabasePassword `json:"adminPassword"`
+}
+
+// Secret holding the SYS password of the external database
+type DataguardBrokerExternalDatabasePassword struct {
+	SecretName string `json:"secretName"`
+	// +kubebuilder:default:="oracle_pwd"
+	SecretKey string `json:"secretKey,omitempty"`
+}
+
+// Far sync instance run by the dataguardbroker
+type DataguardBrokerFarSync struct {
+	// SID and db_unique_name of the far sync instance
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9]{0,11}$`
+	Name string `json:"name"`
+	// Standby databases receiving redo through the far sync, all standby databases when not set
+	StandbyDatabaseRefs []string `json:"standbyDatabaseRefs,omitempty"`
+	// Place the far sync close to the primary database
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// Broker properties of a standby database, properties removed from the settings are reset to their default value
+type DataguardBrokerStandbySettings struct {
+	// Name of the standby SingleInstanceDatabase
+	DatabaseRef string `json:"databaseRef"`
+	// +kubebuilder:validation:Enum=SYNC;FASTSYNC;ASYNC
+	LogXptMode string `json:"logXptMode,omitempty"`
+	// Minutes to delay the apply of redo on the standby
+	// +kubebuilder:validation:Minimum=0
+	DelayMins *int `json:"delayMins,omitempty"`
+	// Seconds of apply lag before the broker reports a warning, 0 disables the warning
+	// +kubebuilder:validation:Minimum=0
+	ApplyLagThreshold *int `json:"applyLagThreshold,omitempty"`
+	// Seconds of transport lag before the broker reports a warning, 0 disables the warning
+	// +kubebuilder:validation:Minimum=0
+	TransportLagThreshold *int `json:"transportLagThreshold,omitempty"`
+}
+
+// Placement of the observer pods relative to each other
+type DataguardBrokerObserverPlacement struct {
+	// Required schedules every observer in a different topology domain, Preferred only tries to
+	// +kubebuilder:validation:Enum=Preferred;Required
+	Policy string `json:"policy,omitempty"`
+	// Defaults to kubernetes.io/hostname
+	TopologyKey string `json:"topologyKey,omitempty"`
 }
 
 // DataguardBrokerStatus defines the observed state of DataguardBroker
@@ -77,6 +157,52 @@ type DataguardBrokerStatus struct {
 
 	FastStartFailover          string            `json:"fastStartFailover,omitempty"`
 	DatabasesInDataguardConfig map[string]string `json:"databasesInDataguardConfig,omitempty"`
+
+	StandbySettings []DataguardBrokerStandbySettings `json:"standbySettings,omitempty"`
+
+	MasterObserver string                          `json:"masterObserver,omitempty"`
+	Observers      []DataguardBrokerObserverStatus `json:"observers,omitempty"`
+
+	FarSync *DataguardBrokerFarSyncStatus `json:"farSync,omitempty"`
+
+	ExternalDatabases []string `json:"externalDatabases,omitempty"`
+
+	ConfigurationStatus string `json:"configurationStatus,omitempty"`
+	// +listType=map
+	// +listMapKey=database
+	StandbyHealth   []DataguardBrokerStandbyHealth `json:"standbyHealth,omitempty"`
+	LastHealthCheck *metav1.Time                   `json:"lastHealthCheck,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// Lag and broker status of a standby database reported by the broker
+type DataguardBrokerStandbyHealth struct {
+	Database            string `json:"database"`
+	TransportLagSeconds *int64 `json:"transportLagSeconds,omitempty"`
+	ApplyLagSeconds     *int64 `json:"applyLagSeconds,omitempty"`
+	Status              string `json:"status,omitempty"`
+	// Error of the last health check of the standby database
+	Error string `json:"error,omitempty"`
+}
+
+// Far sync instance added to the dataguard configuration
+type DataguardBrokerFarSyncStatus struct {
+	Name       string `json:"name"`
+	Pod        string `json:"pod,omitempty"`
+	RedoRoutes string `json:"redoRoutes,omitempty"`
+}
+
+// Fast-start failover observer registered in the broker
+type DataguardBrokerObserverStatus struct {
+	Name string `json:"name"`
+	Pod  string `json:"pod,omitempty"`
+	// Master or Backup
+	Role string `json:"role,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -89,6 +215,8 @@ type DataguardBrokerStatus struct {
 // +kubebuilder:printcolumn:JSONPath=".spec.primaryDatabaseRef",name="Primary Database",type="string", priority=1
 // +kubebuilder:printcolumn:JSONPath=".status.status",name="Status",type="string"
 // +kubebuilder:printcolumn:JSONPath=".status.fastStartFailover",name="FSFO", type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.configurationStatus",name="Health",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.masterObserver",name="Master Observer",type="string",priority=1
 
 // DataguardBroker is the Schema for the dataguardbrokers API
 type DataguardBroker struct {
@@ -109,6 +237,21 @@ func (broker *DataguardBroker) GetCurrentPrimaryDatabase() string {
 	return broker.Spec.PrimaryDatabaseRef
 }
 
+// //////////////////////////////////////////////////////////////////////////////////////////////////
+// Returns the external database added to the dataguard configuration with the given sid
+// //////////////////////////////////////////////////////////////////////////////////////////////////
+func (broker *DataguardBroker) GetExternalDatabase(sid string) *DataguardBrokerExternalDatabase {
+	if !slices.Contains(broker.Status.ExternalDatabases, strings.ToUpper(sid)) {
+		return nil
+	}
+	for i := range broker.Spec.ExternalDatabases {
+		if strings.EqualFold(broker.Spec.ExternalDatabases[i].Sid, sid) {
+			return &broker.Spec.ExternalDatabases[i]
+		}
+	}
+	return nil
+}
+
 // //////////////////////////////////////////////////////////////////////////////////////////////////
 // Returns databases in Dataguard configuration from the resource status/spec
 // //////////////////////////////////////////////////////////////////////////////////////////////////
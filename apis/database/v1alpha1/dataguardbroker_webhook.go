@@ -40,6 +40,7 @@ package v1alpha1
 
 import (
 	"context"
+	"slices"
 	"strconv"
 	"strings"
 	"fmt"
@@ -128,6 +129,54 @@ func (r *DataguardBroker) ValidateCreate(ctx context.Context, obj runtime.Object
 				"Oracle database operator doesn't watch over this namespace"))
 	}
 
+	// Validate the standby settings against the protection mode
+	standbySettingsPath := field.NewPath("spec").Child("standbySettings")
+	asyncStandbys := 0
+	for i, settings := range dg.Spec.StandbySettings {
+		if !slices.Contains(dg.Spec.StandbyDatabaseRefs, settings.DatabaseRef) {
+			allErrs = append(allErrs,
+				field.Invalid(standbySettingsPath.Index(i).Child("databaseRef"), settings.DatabaseRef, "must be one of standbyDatabaseRefs"))
+		}
+		if settings.LogXptMode == "ASYNC" && slices.Contains(dg.Spec.StandbyDatabaseRefs, settings.DatabaseRef) {
+			asyncStandbys++
+		}
+		if settings.LogXptMode == "FASTSYNC" && dg.Spec.ProtectionMode == "MaxProtection" {
+			allErrs = append(allErrs,
+				field.Invalid(standbySettingsPath.Index(i).Child("logXptMode"), settings.LogXptMode, "FASTSYNC is not supported in MaxProtection mode, use SYNC"))
+		}
+		if settings.DelayMins != nil && *settings.DelayMins > 0 && dg.Spec.FastStartFailover {
+			allErrs = append(allErrs,
+				field.Forbidden(standbySettingsPath.Index(i).Child("delayMins"), "a delayed standby cannot be a fastStartFailover target"))
+		}
+	}
+	// Standby databases without a logXptMode are added with SYNC transport in these modes
+	if (dg.Spec.ProtectionMode == "MaxAvailability" || dg.Spec.ProtectionMode == "MaxProtection") &&
+		len(dg.Spec.StandbyDatabaseRefs) > 0 && asyncStandbys == len(dg.Spec.StandbyDatabaseRefs) {
+		allErrs = append(allErrs,
+			field.Invalid(standbySettingsPath, dg.Spec.ProtectionMode, "at least one standby database must use SYNC or FASTSYNC transport"))
+	}
+
+	// The observer and the fast-start failover targets run in this cluster
+	if len(dg.Spec.ExternalDatabases) > 0 && dg.Spec.FastStartFailover {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec").Child("fastStartFailover"), "fastStartFailover is not supported with externalDatabases"))
+	}
+
+	// Validate the standby databases served by the far sync
+	if dg.Spec.FarSync != nil {
+		farSyncPath := field.NewPath("spec").Child("farSync")
+		for i, databaseRef := range dg.Spec.FarSync.StandbyDatabaseRefs {
+			if !slices.Contains(dg.Spec.StandbyDatabaseRefs, databaseRef) {
+				allErrs = append(allErrs,
+					field.Invalid(farSyncPath.Child("standbyDatabaseRefs").Index(i), databaseRef, "must be one of standbyDatabaseRefs"))
+			}
+		}
+		if len(dg.Spec.StandbyDatabaseRefs) == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(farSyncPath, dg.Spec.FarSync.Name, "a far sync requires at least one standby database"))
+		}
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -167,10 +216,6 @@ func (r *DataguardBroker) ValidateUpdate(ctx context.Context, old, newObj runtim
 		return nil, apierrors.NewInternalError(fmt.Errorf("failed to cast old object to DataguardBroker"))
 	}
 
-	if oldObj.Status.ProtectionMode != "" && !strings.EqualFold(new.Spec.ProtectionMode, oldObj.Status.ProtectionMode) {
-		allErrs = append(allErrs,
-			field.Forbidden(field.NewPath("spec").Child("protectionMode"), "cannot be changed"))
-	}
 	if oldObj.Status.PrimaryDatabaseRef != "" && !strings.EqualFold(oldObj.Status.PrimaryDatabaseRef, new.Spec.PrimaryDatabaseRef) {
 		allErrs = append(allErrs,
 			field.Forbidden(field.NewPath("spec").Child("primaryDatabaseRef"), "cannot be changed"))
@@ -40,6 +40,7 @@ package v1alpha1
 
 import (
 	"context"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
@@ -48,6 +49,7 @@ import (
 	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -194,6 +196,11 @@ func (r *SingleInstanceDatabase) ValidateCreate(ctx context.Context, obj runtime
 				field.Invalid(field.NewPath("spec").Child("persistence").Child("accessMode"),
 					sidb.Spec.Persistence.AccessMode, "should be either \"ReadWriteOnce\" or \"ReadWriteMany\""))
 		}
+		if _, err := resource.ParseQuantity(sidb.Spec.Persistence.Size); err != nil {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("persistence").Child("size"),
+					sidb.Spec.Persistence.Size, "should be a valid storage quantity like 100Gi"))
+		}
 	}
 
 	if sidb.Spec.CreateAs == "standby" {
@@ -312,6 +319,44 @@ func (r *SingleInstanceDatabase) ValidateCreate(ctx context.Context, obj runtime
 		}
 	}
 
+	if sidb.Spec.CreateAs == "restore" {
+		if sidb.Spec.RestoreFrom == nil || sidb.Spec.RestoreFrom.BackupRef == "" {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("restoreFrom").Child("backupRef"), sidb.Spec.RestoreFrom,
+					"backupRef must be specified to restore a database"))
+		} else if sidb.Spec.RestoreFrom.Scn != "" && sidb.Spec.RestoreFrom.Timestamp != "" {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("restoreFrom").Child("scn"), sidb.Spec.RestoreFrom.Scn,
+					"scn and timestamp cannot be specified together"))
+		}
+		if sidb.Spec.Persistence.Size == "" {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("persistence").Child("size"), sidb.Spec.Persistence.Size,
+					"persistence must be specified to restore a database"))
+		}
+		if sidb.Spec.Edition == "express" || sidb.Spec.Edition == "free" {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("createAs"), sidb.Spec.CreateAs,
+					"Restore not supported for "+sidb.Spec.Edition+" edition"))
+		}
+		if sidb.Spec.Image.PrebuiltDB {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("createAs"), sidb.Spec.CreateAs,
+					"cannot restore to create a prebuilt db"))
+		}
+	} else if sidb.Spec.RestoreFrom != nil {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec").Child("restoreFrom"), sidb.Spec.RestoreFrom,
+				"restoreFrom can only be specified when createAs is restore"))
+	}
+
+	if sidb.Spec.Patching != nil && sidb.Spec.Patching.Strategy == "standbyFirst" &&
+		(sidb.Spec.Edition == "express" || sidb.Spec.Edition == "free") {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec").Child("patching").Child("strategy"), sidb.Spec.Patching.Strategy,
+				"Standby-first patching not supported for "+sidb.Spec.Edition+" edition"))
+	}
+
 	if sidb.Spec.CreateAs != "truecache" {
 		if len(sidb.Spec.TrueCacheServices) > 0 {
 			allErrs = append(allErrs,
@@ -402,6 +447,85 @@ func (r *SingleInstanceDatabase) ValidateCreate(ctx context.Context, obj runtime
 				" is applicable only for self signed certs"))
 	}
 
+	// tcpsCertIssuerRef validations
+	if sidb.Spec.TcpsCertIssuerRef != nil {
+		if !sidb.Spec.EnableTCPS {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("tcpsCertIssuerRef"),
+					" is allowed only if enableTCPS is true"))
+		}
+		if sidb.Spec.TcpsTlsSecret != "" {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("tcpsTlsSecret"),
+					" cannot be specified with tcpsCertIssuerRef"))
+		}
+		if sidb.Spec.TcpsCertRenewInterval != "" {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("tcpsCertRenewInterval"),
+					" is applicable only for self signed certs, cert-manager renews the certificate"))
+		}
+		if sidb.Spec.TcpsCertIssuerRef.Duration != "" {
+			if _, err := time.ParseDuration(sidb.Spec.TcpsCertIssuerRef.Duration); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(field.NewPath("spec").Child("tcpsCertIssuerRef").Child("duration"), sidb.Spec.TcpsCertIssuerRef.Duration,
+						"Please provide valid string to parse the duration like 2160h"))
+			}
+		}
+	}
+
+	// parameters validations
+	for i, param := range sidb.Spec.Parameters {
+		switch strings.ToLower(param.Name) {
+		case "sga_target", "pga_aggregate_target", "cpu_count", "processes":
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("parameters").Index(i).Child("name"), param.Name, "is managed with initParams"))
+		}
+	}
+
+	// readReplicas validations
+	if sidb.Spec.ReadReplicas != 0 {
+		if sidb.Spec.CreateAs == "standby" || sidb.Spec.CreateAs == "truecache" {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("readReplicas"), "cannot be specified for a "+sidb.Spec.CreateAs+" database"))
+		}
+		if sidb.Spec.Edition == "express" || sidb.Spec.Edition == "free" || sidb.Spec.Edition == "standard" {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("readReplicas"), "Active Data Guard read replicas need the enterprise edition"))
+		}
+		if sidb.Spec.ArchiveLog == nil || !*sidb.Spec.ArchiveLog || sidb.Spec.ForceLogging == nil || !*sidb.Spec.ForceLogging ||
+			sidb.Spec.FlashBack == nil || !*sidb.Spec.FlashBack {
+			allErrs = append(allErrs,
+				field.Invalid(field.NewPath("spec").Child("readReplicas"), sidb.Spec.ReadReplicas, "archiveLog, forceLog and flashBack must be true to create read replicas"))
+		}
+		if sidb.Spec.AdminPassword.KeepSecret != nil && !*sidb.Spec.AdminPassword.KeepSecret {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("adminPassword").Child("keepSecret"), "must be true to create read replicas"))
+		}
+	}
+
+	// pdbs validations
+	if len(sidb.Spec.Pdbs) != 0 && (sidb.Spec.CreateAs == "standby" || sidb.Spec.CreateAs == "truecache") {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec").Child("pdbs"), "cannot be specified for a "+sidb.Spec.CreateAs+" database, pluggable databases are managed on the primary"))
+	}
+	pdbNames := make(map[string]bool)
+	for i, pdb := range sidb.Spec.Pdbs {
+		pdbPath := field.NewPath("spec").Child("pdbs").Index(i)
+		if strings.EqualFold(pdb.Name, sidb.Spec.Pdbname) {
+			allErrs = append(allErrs,
+				field.Invalid(pdbPath.Child("name"), pdb.Name, "is the pluggable database created with the database (pdbName)"))
+		}
+		if pdbNames[strings.ToUpper(pdb.Name)] {
+			allErrs = append(allErrs,
+				field.Duplicate(pdbPath.Child("name"), pdb.Name))
+		}
+		pdbNames[strings.ToUpper(pdb.Name)] = true
+		if strings.EqualFold(pdb.CloneFrom, pdb.Name) {
+			allErrs = append(allErrs,
+				field.Invalid(pdbPath.Child("cloneFrom"), pdb.CloneFrom, "a pluggable database cannot be cloned from itself"))
+		}
+	}
+
 	if sidb.Spec.InitParams != nil {
 		if (sidb.Spec.InitParams.PgaAggregateTarget != 0 && sidb.Spec.InitParams.SgaTarget == 0) || (sidb.Spec.InitParams.PgaAggregateTarget == 0 && sidb.Spec.InitParams.SgaTarget != 0) {
 			allErrs = append(allErrs,
@@ -460,6 +584,23 @@ func (r *SingleInstanceDatabase) ValidateUpdate(ctx context.Context, oldRuntimeO
 		}
 	}
 
+	if old.Spec.CreateAs == "restore" {
+		if old.Spec.RestoreFrom != nil && (new.Spec.RestoreFrom == nil || *old.Spec.RestoreFrom != *new.Spec.RestoreFrom) {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("restoreFrom"), "restoreFrom of a restored singleinstancedatabase cannot be changed post creation"))
+		}
+	}
+
+	if old.Status.RollingPatch != nil && (old.Status.RollingPatch.Image != new.Spec.Image.PullFrom ||
+		old.Status.RollingPatch.Version != new.Spec.Image.Version) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec").Child("image"), "cannot be changed while a rolling patch to "+old.Status.RollingPatch.Image+" is in progress"))
+	}
+	if old.Status.RollingPatch != nil && new.Spec.Maintenance && !old.Spec.Maintenance {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec").Child("maintenance"), "cannot be set while a rolling patch is in progress"))
+	}
+
 	if old.Status.Role != dbcommons.ValueUnavailable && old.Status.Role != "PRIMARY" {
 		// Restriciting Patching of secondary databases archiveLog, forceLog, flashBack
 		statusArchiveLog, _ := strconv.ParseBool(old.Status.ArchiveLog)
@@ -478,6 +619,11 @@ func (r *SingleInstanceDatabase) ValidateUpdate(ctx context.Context, oldRuntimeO
 				field.Forbidden(field.NewPath("spec").Child("forceLog"), "cannot be changed"))
 		}
 
+		if !reflect.DeepEqual(old.Spec.Parameters, new.Spec.Parameters) {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("parameters"), "cannot be changed"))
+		}
+
 		// Restriciting Patching of secondary databases InitParams
 		if new.Spec.InitParams != nil {
 			if old.Status.InitParams.SgaTarget != new.Spec.InitParams.SgaTarget {
@@ -499,6 +645,12 @@ func (r *SingleInstanceDatabase) ValidateUpdate(ctx context.Context, oldRuntimeO
 		}
 	}
 
+	if old.Status.Role != dbcommons.ValueUnavailable && old.Status.Role != "" && old.Status.Role != "PRIMARY" &&
+		!reflect.DeepEqual(old.Spec.Pdbs, new.Spec.Pdbs) {
+		allErrs = append(allErrs,
+			field.Forbidden(field.NewPath("spec").Child("pdbs"), "cannot be changed while the database is not the primary"))
+	}
+
 	// if Db is in a dataguard configuration or referred by Standby databases then Restrict enabling Tcps on the Primary DB
 	if new.Spec.EnableTCPS {
 		if old.Status.DgBroker != nil {
@@ -536,6 +688,17 @@ func (r *SingleInstanceDatabase) ValidateUpdate(ctx context.Context, oldRuntimeO
 		allErrs = append(allErrs,
 			field.Forbidden(field.NewPath("spec").Child("primaryDatabaseRef"), "cannot be changed"))
 	}
+	// Persistent volume claims can only be expanded
+	if old.Spec.Persistence.Size != "" && new.Spec.Persistence.Size != "" {
+		oldSize, oldErr := resource.ParseQuantity(old.Spec.Persistence.Size)
+		newSize, newErr := resource.ParseQuantity(new.Spec.Persistence.Size)
+		if oldErr == nil && newErr == nil && newSize.Cmp(oldSize) < 0 {
+			allErrs = append(allErrs,
+				field.Forbidden(field.NewPath("spec").Child("persistence").Child("size"),
+					"cannot be decreased from "+old.Spec.Persistence.Size+" to "+new.Spec.Persistence.Size+", the datafiles volume can only be expanded"))
+		}
+	}
+
 	if old.Status.OrdsReference != "" && new.Status.Persistence != new.Spec.Persistence {
 		allErrs = append(allErrs,
 			field.Forbidden(field.NewPath("spec").Child("persistence"), "uninstall ORDS to change Persistence"))
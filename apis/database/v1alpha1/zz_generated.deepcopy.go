@@ -796,6 +796,79 @@ func (in *DataguardBroker) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerExternalDatabase) DeepCopyInto(out *DataguardBrokerExternalDatabase) {
+	*out = *in
+	out.AdminPassword = in.AdminPassword
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerExternalDatabase.
+func (in *DataguardBrokerExternalDatabase) DeepCopy() *DataguardBrokerExternalDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerExternalDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerExternalDatabasePassword) DeepCopyInto(out *DataguardBrokerExternalDatabasePassword) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerExternalDatabasePassword.
+func (in *DataguardBrokerExternalDatabasePassword) DeepCopy() *DataguardBrokerExternalDatabasePassword {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerExternalDatabasePassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerFarSync) DeepCopyInto(out *DataguardBrokerFarSync) {
+	*out = *in
+	if in.StandbyDatabaseRefs != nil {
+		in, out := &in.StandbyDatabaseRefs, &out.StandbyDatabaseRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerFarSync.
+func (in *DataguardBrokerFarSync) DeepCopy() *DataguardBrokerFarSync {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerFarSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerFarSyncStatus) DeepCopyInto(out *DataguardBrokerFarSyncStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerFarSyncStatus.
+func (in *DataguardBrokerFarSyncStatus) DeepCopy() *DataguardBrokerFarSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerFarSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataguardBrokerList) DeepCopyInto(out *DataguardBrokerList) {
 	*out = *in
@@ -828,6 +901,36 @@ func (in *DataguardBrokerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerObserverPlacement) DeepCopyInto(out *DataguardBrokerObserverPlacement) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerObserverPlacement.
+func (in *DataguardBrokerObserverPlacement) DeepCopy() *DataguardBrokerObserverPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerObserverPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerObserverStatus) DeepCopyInto(out *DataguardBrokerObserverStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerObserverStatus.
+func (in *DataguardBrokerObserverStatus) DeepCopy() *DataguardBrokerObserverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerObserverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataguardBrokerSpec) DeepCopyInto(out *DataguardBrokerSpec) {
 	*out = *in
@@ -850,6 +953,28 @@ func (in *DataguardBrokerSpec) DeepCopyInto(out *DataguardBrokerSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ObserverPlacement != nil {
+		in, out := &in.ObserverPlacement, &out.ObserverPlacement
+		*out = new(DataguardBrokerObserverPlacement)
+		**out = **in
+	}
+	if in.StandbySettings != nil {
+		in, out := &in.StandbySettings, &out.StandbySettings
+		*out = make([]DataguardBrokerStandbySettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FarSync != nil {
+		in, out := &in.FarSync, &out.FarSync
+		*out = new(DataguardBrokerFarSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalDatabases != nil {
+		in, out := &in.ExternalDatabases, &out.ExternalDatabases
+		*out = make([]DataguardBrokerExternalDatabase, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerSpec.
@@ -862,6 +987,61 @@ func (in *DataguardBrokerSpec) DeepCopy() *DataguardBrokerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerStandbyHealth) DeepCopyInto(out *DataguardBrokerStandbyHealth) {
+	*out = *in
+	if in.TransportLagSeconds != nil {
+		in, out := &in.TransportLagSeconds, &out.TransportLagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ApplyLagSeconds != nil {
+		in, out := &in.ApplyLagSeconds, &out.ApplyLagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStandbyHealth.
+func (in *DataguardBrokerStandbyHealth) DeepCopy() *DataguardBrokerStandbyHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerStandbyHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerStandbySettings) DeepCopyInto(out *DataguardBrokerStandbySettings) {
+	*out = *in
+	if in.DelayMins != nil {
+		in, out := &in.DelayMins, &out.DelayMins
+		*out = new(int)
+		**out = **in
+	}
+	if in.ApplyLagThreshold != nil {
+		in, out := &in.ApplyLagThreshold, &out.ApplyLagThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.TransportLagThreshold != nil {
+		in, out := &in.TransportLagThreshold, &out.TransportLagThreshold
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStandbySettings.
+func (in *DataguardBrokerStandbySettings) DeepCopy() *DataguardBrokerStandbySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerStandbySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataguardBrokerStatus) DeepCopyInto(out *DataguardBrokerStatus) {
 	*out = *in
@@ -872,6 +1052,46 @@ func (in *DataguardBrokerStatus) DeepCopyInto(out *DataguardBrokerStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.StandbySettings != nil {
+		in, out := &in.StandbySettings, &out.StandbySettings
+		*out = make([]DataguardBrokerStandbySettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Observers != nil {
+		in, out := &in.Observers, &out.Observers
+		*out = make([]DataguardBrokerObserverStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FarSync != nil {
+		in, out := &in.FarSync, &out.FarSync
+		*out = new(DataguardBrokerFarSyncStatus)
+		**out = **in
+	}
+	if in.ExternalDatabases != nil {
+		in, out := &in.ExternalDatabases, &out.ExternalDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StandbyHealth != nil {
+		in, out := &in.StandbyHealth, &out.StandbyHealth
+		*out = make([]DataguardBrokerStandbyHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastHealthCheck != nil {
+		in, out := &in.LastHealthCheck, &out.LastHealthCheck
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStatus.
@@ -2176,6 +2396,21 @@ func (in *SingleInstanceDatabaseAdminPassword) DeepCopy() *SingleInstanceDatabas
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseCertIssuerRef) DeepCopyInto(out *SingleInstanceDatabaseCertIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseCertIssuerRef.
+func (in *SingleInstanceDatabaseCertIssuerRef) DeepCopy() *SingleInstanceDatabaseCertIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseCertIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleInstanceDatabaseImage) DeepCopyInto(out *SingleInstanceDatabaseImage) {
 	*out = *in
@@ -2238,6 +2473,81 @@ func (in *SingleInstanceDatabaseList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseParameter) DeepCopyInto(out *SingleInstanceDatabaseParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseParameter.
+func (in *SingleInstanceDatabaseParameter) DeepCopy() *SingleInstanceDatabaseParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseParameterStatus) DeepCopyInto(out *SingleInstanceDatabaseParameterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseParameterStatus.
+func (in *SingleInstanceDatabaseParameterStatus) DeepCopy() *SingleInstanceDatabaseParameterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseParameterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePatching) DeepCopyInto(out *SingleInstanceDatabasePatching) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePatching.
+func (in *SingleInstanceDatabasePatching) DeepCopy() *SingleInstanceDatabasePatching {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePdb) DeepCopyInto(out *SingleInstanceDatabasePdb) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePdb.
+func (in *SingleInstanceDatabasePdb) DeepCopy() *SingleInstanceDatabasePdb {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePdb)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePdbStatus) DeepCopyInto(out *SingleInstanceDatabasePdbStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePdbStatus.
+func (in *SingleInstanceDatabasePdbStatus) DeepCopy() *SingleInstanceDatabasePdbStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePdbStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleInstanceDatabasePersistence) DeepCopyInto(out *SingleInstanceDatabasePersistence) {
 	*out = *in
@@ -2298,6 +2608,63 @@ func (in *SingleInstanceDatabaseResources) DeepCopy() *SingleInstanceDatabaseRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseRestoreSource) DeepCopyInto(out *SingleInstanceDatabaseRestoreSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseRestoreSource.
+func (in *SingleInstanceDatabaseRestoreSource) DeepCopy() *SingleInstanceDatabaseRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseRollingPatchStatus) DeepCopyInto(out *SingleInstanceDatabaseRollingPatchStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseRollingPatchStatus.
+func (in *SingleInstanceDatabaseRollingPatchStatus) DeepCopy() *SingleInstanceDatabaseRollingPatchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseRollingPatchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseSnapshotStandbyStatus) DeepCopyInto(out *SingleInstanceDatabaseSnapshotStandbyStatus) {
+	*out = *in
+	if in.ConvertedAt != nil {
+		in, out := &in.ConvertedAt, &out.ConvertedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RevertAt != nil {
+		in, out := &in.RevertAt, &out.RevertAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRevertedAt != nil {
+		in, out := &in.LastRevertedAt, &out.LastRevertedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseSnapshotStandbyStatus.
+func (in *SingleInstanceDatabaseSnapshotStandbyStatus) DeepCopy() *SingleInstanceDatabaseSnapshotStandbyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseSnapshotStandbyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSpec) {
 	*out = *in
@@ -2323,11 +2690,31 @@ func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TcpsCertIssuerRef != nil {
+		in, out := &in.TcpsCertIssuerRef, &out.TcpsCertIssuerRef
+		*out = new(SingleInstanceDatabaseCertIssuerRef)
+		**out = **in
+	}
 	if in.TrueCacheServices != nil {
 		in, out := &in.TrueCacheServices, &out.TrueCacheServices
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(SingleInstanceDatabaseRestoreSource)
+		**out = **in
+	}
+	if in.Patching != nil {
+		in, out := &in.Patching, &out.Patching
+		*out = new(SingleInstanceDatabasePatching)
+		**out = **in
+	}
+	if in.Pdbs != nil {
+		in, out := &in.Pdbs, &out.Pdbs
+		*out = make([]SingleInstanceDatabasePdb, len(*in))
+		copy(*out, *in)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -2344,6 +2731,30 @@ func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSp
 		**out = **in
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]SingleInstanceDatabaseParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseSpec.
@@ -2385,6 +2796,31 @@ func (in *SingleInstanceDatabaseStatus) DeepCopyInto(out *SingleInstanceDatabase
 	}
 	out.InitParams = in.InitParams
 	in.Persistence.DeepCopyInto(&out.Persistence)
+	if in.SnapshotStandby != nil {
+		in, out := &in.SnapshotStandby, &out.SnapshotStandby
+		*out = new(SingleInstanceDatabaseSnapshotStandbyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingPatch != nil {
+		in, out := &in.RollingPatch, &out.RollingPatch
+		*out = new(SingleInstanceDatabaseRollingPatchStatus)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]SingleInstanceDatabaseParameterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pdbs != nil {
+		in, out := &in.Pdbs, &out.Pdbs
+		*out = make([]SingleInstanceDatabasePdbStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadReplicas != nil {
+		in, out := &in.ReadReplicas, &out.ReadReplicas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseStatus.
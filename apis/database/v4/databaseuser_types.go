@@ -0,0 +1,164 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package v4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatabaseUserSpec defines the desired state of DatabaseUser
+// +kubebuilder:validation:XValidation:rule="has(self.pdbName) || self.username.upperAscii().startsWith('C##')",message="username of a common user created in the root container must start with C##"
+type DatabaseUserSpec struct {
+	// Name of the SingleInstanceDatabase the user is created in
+	// +kubebuilder:validation:Required
+	SingleInstanceDatabaseRef string `json:"singleInstanceDatabaseRef"`
+	// Pluggable database the user is created in, the user is a common user of the root container when not set
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]{0,29}$`
+	PdbName string `json:"pdbName,omitempty"`
+
+	// +kubebuilder:validation:Pattern=`^([cC]##)?[a-zA-Z][a-zA-Z0-9_#]{0,127}$`
+	Username       string                     `json:"username"`
+	PasswordSecret DatabaseUserPasswordSecret `json:"passwordSecret"`
+
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_#]{0,29}$`
+	DefaultTablespace string `json:"defaultTablespace,omitempty"`
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_#]{0,29}$`
+	TemporaryTablespace string              `json:"temporaryTablespace,omitempty"`
+	Quotas              []DatabaseUserQuota `json:"quotas,omitempty"`
+	AccountLocked       bool                `json:"accountLocked,omitempty"`
+
+	Roles            []DatabaseUserRole        `json:"roles,omitempty"`
+	SystemPrivileges []DatabaseUserPriv        `json:"systemPrivileges,omitempty"`
+	ObjectGrants     []DatabaseUserObjectGrant `json:"objectGrants,omitempty"`
+
+	// Delete drops the user and its objects when the DatabaseUser is deleted, Retain leaves it in the database
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +kubebuilder:default:=Retain
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// DatabaseUserPasswordSecret defines the secret holding the password of the user.
+// The password is changed in the database whenever the secret changes.
+type DatabaseUserPasswordSecret struct {
+	SecretName string `json:"secretName"`
+	// +kubebuilder:default:="password"
+	SecretKey string `json:"secretKey,omitempty"`
+}
+
+// DatabaseUserQuota defines the quota of the user on a tablespace
+type DatabaseUserQuota struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_#]{0,29}$`
+	Tablespace string `json:"tablespace"`
+	// Size with an optional K, M, G or T suffix, or UNLIMITED
+	// +kubebuilder:validation:Pattern=`^([0-9]+[KMGT]?|UNLIMITED|unlimited)$`
+	Size string `json:"size"`
+}
+
+// DatabaseUserRole is the name of a granted role
+// +kubebuilder:validation:Pattern=`^([cC]##)?[a-zA-Z][a-zA-Z0-9_#]{0,127}$`
+type DatabaseUserRole string
+
+// DatabaseUserPriv is the name of a system or object privilege, e.g. CREATE SESSION
+// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z ]{0,63}$`
+type DatabaseUserPriv string
+
+// DatabaseUserObjectGrant defines privileges granted on a schema object
+type DatabaseUserObjectGrant struct {
+	// Schema qualified object name, e.g. HR.EMPLOYEES
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_#]{0,127}\.[a-zA-Z][a-zA-Z0-9_#]{0,127}$`
+	Object string `json:"object"`
+	// +kubebuilder:validation:MinItems=1
+	Privileges []DatabaseUserPriv `json:"privileges"`
+}
+
+// DatabaseUserStatus defines the observed state of DatabaseUser
+type DatabaseUserStatus struct {
+	Status  string `json:"status,omitempty"`
+	Sid     string `json:"sid,omitempty"`
+	PdbName string `json:"pdbName,omitempty"`
+	// Resource version of the password secret last applied to the database
+	PasswordSecretVersion string `json:"passwordSecretVersion,omitempty"`
+	AccountStatus         string `json:"accountStatus,omitempty"`
+
+	// Roles and privileges granted by the operator, revoked once they are removed from the spec
+	GrantedRoles            []string `json:"grantedRoles,omitempty"`
+	GrantedSystemPrivileges []string `json:"grantedSystemPrivileges,omitempty"`
+	GrantedObjectPrivileges []string `json:"grantedObjectPrivileges,omitempty"`
+
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=dbuser;dbusers
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.singleInstanceDatabaseRef",name="Database",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.pdbName",name="Pdb",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.username",name="Username",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.status",name="Status",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.accountStatus",name="Account",type="string",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.lastSyncTime",name="Last Sync",type="date",priority=1
+
+// DatabaseUser is the Schema for the databaseusers API
+// +kubebuilder:storageversion
+type DatabaseUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatabaseUserSpec   `json:"spec,omitempty"`
+	Status DatabaseUserStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DatabaseUserList contains a list of DatabaseUser
+type DatabaseUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatabaseUser `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatabaseUser{}, &DatabaseUserList{})
+}
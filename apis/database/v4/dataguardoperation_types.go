@@ -0,0 +1,108 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+package v4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataguardOperationSpec defines the operation to run on a dataguard configuration
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+type DataguardOperationSpec struct {
+	// Name of the DataguardBroker managing the dataguard configuration
+	// +kubebuilder:validation:Required
+	DataguardBrokerRef string `json:"dataguardBrokerRef"`
+
+	// +kubebuilder:validation:Enum=switchover;failover;convertToSnapshotStandby;convertToPhysicalStandby;reinstate
+	Operation string `json:"operation"`
+
+	// SID of the database the operation is run on
+	TargetDatabase string `json:"targetDatabase"`
+
+	// Run FAILOVER IMMEDIATE, without applying the redo received by the target database
+	Immediate bool `json:"immediate,omitempty"`
+	// Run the operation without the VALIDATE DATABASE prechecks
+	SkipPrechecks bool `json:"skipPrechecks,omitempty"`
+}
+
+// DataguardOperationStatus records the outcome of the operation
+type DataguardOperationStatus struct {
+	Status string `json:"status,omitempty"`
+	// Primary database when the operation started
+	PrimaryDatabase string       `json:"primaryDatabase,omitempty"`
+	StartTime       *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime  *metav1.Time `json:"completionTime,omitempty"`
+	Message         string       `json:"message,omitempty"`
+	// DGMGRL output of the prechecks and of the operation
+	PrecheckOutput string `json:"precheckOutput,omitempty"`
+	Output         string `json:"output,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=dgop;dgops
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.dataguardBrokerRef",name="Broker",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.operation",name="Operation",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.targetDatabase",name="Target",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.status",name="Status",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.startTime",name="Started",type="date"
+// +kubebuilder:printcolumn:JSONPath=".status.completionTime",name="Completed",type="date",priority=1
+// +kubebuilder:printcolumn:JSONPath=".status.message",name="Message",type="string",priority=1
+
+// DataguardOperation is the Schema for the dataguardoperations API
+// +kubebuilder:storageversion
+type DataguardOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataguardOperationSpec   `json:"spec,omitempty"`
+	Status DataguardOperationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// DataguardOperationList contains a list of DataguardOperation
+type DataguardOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataguardOperation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataguardOperation{}, &DataguardOperationList{})
+}
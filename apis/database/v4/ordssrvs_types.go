@@ -40,11 +40,15 @@ package v4
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // OrdsSrvsSpec defines the desired state of OrdsSrvs
 // +kubebuilder:resource:shortName="ords"
+// +kubebuilder:validation:XValidation:rule="!has(self.autoscaling) || self.workloadType != 'DaemonSet'",message="autoscaling is not supported for workloadType DaemonSet"
+// +kubebuilder:validation:XValidation:rule="!(has(self.ingress) && has(self.httpRoute))",message="only one of ingress and httpRoute can be set"
 type OrdsSrvsSpec struct {
 	
 	// Specifies the desired Kubernetes Workload
@@ -86,6 +90,176 @@ type OrdsSrvsSpec struct {
 	// +k8s:openapi-gen=true
 	ServiceAccountName string `json:"serviceAccountName,omitempty"`
 
+	// Specifies the compute resources of the ORDS container, CPU requests are required for CPU based autoscaling
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// Specifies a HorizontalPodAutoscaler for the Deployment or StatefulSet, replicas is then managed by the autoscaler
+	Autoscaling *OrdsSrvsAutoscaling `json:"autoscaling,omitempty"`
+
+	// Specifies the PodDisruptionBudget of the ORDS pods
+	// Generated with maxUnavailable 1 when more than one replica is defined
+	PodDisruptionBudget *OrdsSrvsPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+
+	// Specifies an Ingress routing standalone.context.path to the ORDS Service
+	Ingress *OrdsSrvsIngress `json:"ingress,omitempty"`
+
+	// Specifies a Gateway API HTTPRoute routing standalone.context.path to the ORDS Service
+	HTTPRoute *OrdsSrvsHTTPRoute `json:"httpRoute,omitempty"`
+
+	// Specifies the interval in seconds between connection checks of the pools, 0 disables the checks
+	//+kubebuilder:validation:Minimum=0
+	//+kubebuilder:default=300
+	PoolHealthCheckInterval *int32 `json:"poolHealthCheckInterval,omitempty"`
+
+	// Specifies the access to the OCI Vault and HashiCorp Vault secrets referenced in vaultSecrets of the pools
+	Vault *OrdsSrvsVault `json:"vault,omitempty"`
+
+	// Specifies a rolling update of the pods when Global or Pool configurations change, using versioned ConfigMaps
+	// The previous configuration is restored when the new pods do not become ready
+	RollingConfigUpdate *OrdsSrvsRollingConfigUpdate `json:"rollingConfigUpdate,omitempty"`
+
+}
+
+type OrdsSrvsRollingConfigUpdate struct {
+
+	// Specifies the path of the ORDS readiness probe, relative to standalone.context.path
+	//+kubebuilder:default:="/_/landing"
+	HealthPath string `json:"healthPath,omitempty"`
+
+	// Specifies the seconds the new pods have to become ready before the previous configuration is restored
+	//+kubebuilder:validation:Minimum=60
+	//+kubebuilder:default=600
+	ProgressDeadlineSeconds int32 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self.maxReplicas >= self.minReplicas",message="maxReplicas must be greater than or equal to minReplicas"
+type OrdsSrvsAutoscaling struct {
+
+	// Specifies the minimum number of replicas
+	//+kubebuilder:validation:Minimum=1
+	//+kubebuilder:default=1
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+
+	// Specifies the maximum number of replicas
+	//+kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Specifies the target average CPU utilization, in percent of the requested CPU, 80 when no metrics are set
+	//+kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// Specifies the target average values of custom pod metrics, for example REST requests per second exposed through a metrics adapter
+	Metrics []OrdsSrvsAutoscalingMetric `json:"metrics,omitempty"`
+}
+
+type OrdsSrvsAutoscalingMetric struct {
+
+	// Specifies the name of the pod metric
+	Name string `json:"name"`
+
+	// Specifies the target average value of the metric across the pods
+	TargetAverageValue resource.Quantity `json:"targetAverageValue"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!(has(self.minAvailable) && has(self.maxUnavailable))",message="only one of minAvailable and maxUnavailable can be set"
+type OrdsSrvsPodDisruptionBudget struct {
+
+	// Specifies whether to generate the PodDisruptionBudget
+	//+kubebuilder:default=true
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Specifies the number or percentage of pods that must remain available during a disruption
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// Specifies the number or percentage of pods that can be unavailable during a disruption
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+type OrdsSrvsVault struct {
+
+	// Specifies the OCI authentication for OCI Vault secrets, Instance Principal when empty
+	OciConfig OciConfigSpec `json:"ociConfig,omitempty"`
+
+	// Specifies the HashiCorp Vault server for HashiCorp Vault secrets
+	HashiCorp *HashiCorpVault `json:"hashicorp,omitempty"`
+
+	// Specifies the interval in seconds between synchronizations of the Vault secrets
+	//+kubebuilder:validation:Minimum=60
+	//+kubebuilder:default=300
+	SyncInterval int32 `json:"syncInterval,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.tokenSecret) != has(self.kubernetesAuthRole)",message="one of tokenSecret and kubernetesAuthRole must be set"
+// +kubebuilder:validation:XValidation:rule="has(self.kubernetesAuthRole) == has(self.kubernetesAuthServiceAccount)",message="kubernetesAuthRole and kubernetesAuthServiceAccount must be set together"
+type HashiCorpVault struct {
+
+	// Specifies the address of the HashiCorp Vault server, e.g. https://vault.vault.svc:8200
+	Address string `json:"address"`
+
+	// Specifies the mount path of the KV version 2 secrets engine
+	//+kubebuilder:default:="secret"
+	Mount string `json:"mount,omitempty"`
+
+	// Specifies the Vault Enterprise namespace
+	Namespace string `json:"namespace,omitempty"`
+
+	// Specifies the Secret holding the CA certificate of an https address, the system CAs are trusted when not set
+	CASecret *CACertificateSecret `json:"caSecret,omitempty"`
+
+	// Specifies the Secret holding a Vault token
+	TokenSecret *PasswordSecret `json:"tokenSecret,omitempty"`
+
+	// Specifies the role of the Kubernetes auth method
+	KubernetesAuthRole string `json:"kubernetesAuthRole,omitempty"`
+
+	// Specifies the ServiceAccount in the namespace of the OrdsSrvs logging in with the Kubernetes auth method,
+	// with a short-lived token issued for the "vault" audience
+	KubernetesAuthServiceAccount string `json:"kubernetesAuthServiceAccount,omitempty"`
+
+	// Specifies the mount path of the Kubernetes auth method
+	//+kubebuilder:default:="kubernetes"
+	KubernetesAuthMount string `json:"kubernetesAuthMount,omitempty"`
+}
+
+type OrdsSrvsIngress struct {
+
+	// Specifies the IngressClass of the Ingress
+	IngressClassName string `json:"ingressClassName,omitempty"`
+
+	// Specifies the host name of the Ingress
+	Host string `json:"host,omitempty"`
+
+	// Specifies the kubernetes.io/tls Secret terminating TLS at the Ingress
+	// Defaults to the certSecret of the globalSettings when its keys are tls.crt and tls.key
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+
+	// Specifies the annotations of the Ingress, for example for the ingress controller
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type OrdsSrvsHTTPRoute struct {
+
+	// Specifies the Gateways the HTTPRoute attaches to
+	//+kubebuilder:validation:MinItems=1
+	ParentRefs []OrdsSrvsGatewayRef `json:"parentRefs"`
+
+	// Specifies the host names of the HTTPRoute
+	Hostnames []string `json:"hostnames,omitempty"`
+
+	// Specifies the annotations of the HTTPRoute
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type OrdsSrvsGatewayRef struct {
+
+	// Specifies the name of the Gateway
+	Name string `json:"name"`
+
+	// Specifies the namespace of the Gateway, defaults to the namespace of the resource
+	Namespace string `json:"namespace,omitempty"`
+
+	// Specifies the listener of the Gateway
+	SectionName string `json:"sectionName,omitempty"`
 }
 
 type GlobalSettings struct {
@@ -403,6 +577,9 @@ type PoolSettings struct {
 	// Replaces: db.cdb.adminUser.password
 	DBCDBAdminUserSecret PasswordSecret `json:"db.cdb.adminUser.secret,omitempty"`
 
+	// Specifies the Vault secrets of the pool passwords, replacing db.secret, db.adminUser.secret and db.cdb.adminUser.secret
+	VaultSecrets *PoolVaultSecrets `json:"vaultSecrets,omitempty"`
+
 	// Specifies the comma delimited list of additional roles to assign authenticated APEX administrator type users.
 	ApexSecurityAdministratorRoles string `json:"apex.security.administrator.roles,omitempty"`
 
@@ -653,6 +830,28 @@ type PriVKey struct {
 	Secret PasswordSecret `json:"secret"`
 }
 
+// Defines the Vault secrets containing the passwords of a pool
+type PoolVaultSecrets struct {
+	// Specifies the Vault secret of the db.username password
+	DBPassword *VaultSecret `json:"db.password,omitempty"`
+	// Specifies the Vault secret of the db.adminUser password
+	DBAdminUserPassword *VaultSecret `json:"db.adminUser.password,omitempty"`
+	// Specifies the Vault secret of the db.cdb.adminUser password
+	DBCDBAdminUserPassword *VaultSecret `json:"db.cdb.adminUser.password,omitempty"`
+}
+
+// Defines a secret stored in OCI Vault or HashiCorp Vault
+// +kubebuilder:validation:XValidation:rule="has(self.ociSecretOCID) != has(self.hashicorpPath)",message="one of ociSecretOCID and hashicorpPath must be set"
+type VaultSecret struct {
+	// Specifies the OCID of an OCI Vault secret
+	OCISecretOCID string `json:"ociSecretOCID,omitempty"`
+	// Specifies the path of a HashiCorp Vault secret in the KV version 2 mount, e.g. ords/pdb1
+	HashiCorpPath string `json:"hashicorpPath,omitempty"`
+	// Specifies the key holding the password in the HashiCorp Vault secret
+	//+kubebuilder:default:="password"
+	HashiCorpKey string `json:"hashicorpKey,omitempty"`
+}
+
 // Defines the secret containing Password mapped to secretKey
 type PasswordSecret struct {
 	// Specifies the name of the password Secret
@@ -672,6 +871,15 @@ type CertificateSecret struct {
 	CertificateKey string `json:"key"`
 }
 
+// Defines the secret containing a CA certificate
+type CACertificateSecret struct {
+	// Specifies the name of the CA certificate Secret
+	SecretName string `json:"secretName"`
+	// Specifies the key holding the PEM encoded CA certificate
+	//+kubebuilder:default:="ca.crt"
+	CertificateKey string `json:"certificateKey,omitempty"`
+}
+
 // Defines a secret containing tns admin folder (network/admin), e.g. tnsnames.ora
 type TNSAdminSecret struct {
 	// Specifies the name of the Secret
@@ -712,11 +920,52 @@ type OrdsSrvsStatus struct {
 	// Indicates if the resource is out-of-sync with the configuration
 	RestartRequired bool `json:"restartRequired"`
 
+	// Indicates the external URL of ORDS, when exposed with an Ingress or HTTPRoute
+	ExternalURL string `json:"externalUrl,omitempty"`
+
+	// Indicates the result of the last connection check of each pool
+	// +listType=map
+	// +listMapKey=poolName
+	Pools []OrdsSrvsPoolStatus `json:"pools,omitempty"`
+
+	// Indicates the rollout of the versioned configuration, when rollingConfigUpdate is set
+	ConfigRollout *OrdsSrvsConfigRolloutStatus `json:"configRollout,omitempty"`
+
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
 
 }
 
+// OrdsSrvsPoolStatus defines the observed state of a pool
+type OrdsSrvsPoolStatus struct {
+	// Indicates the name of the pool
+	PoolName string `json:"poolName"`
+	// Indicates if the pool connected to its database during the last check
+	Validated bool `json:"validated"`
+	// Indicates the pool was not checked, its database connect string is not known
+	Unchecked bool `json:"unchecked,omitempty"`
+	// Indicates the error of the last failed check
+	LastError string `json:"lastError,omitempty"`
+	// Indicates the ORDS version installed in the database
+	ORDSVersion string `json:"ordsVersion,omitempty"`
+	// Indicates the APEX version installed in the database
+	APEXVersion string `json:"apexVersion,omitempty"`
+	// Indicates the time of the last check
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// OrdsSrvsConfigRolloutStatus defines the observed state of a rolling configuration update
+type OrdsSrvsConfigRolloutStatus struct {
+	// Indicates the configuration version running on all pods
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	// Indicates the configuration version being rolled out
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Indicates the start time of the rollout of the target version
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// Indicates the last configuration version rolled back because its pods did not become ready
+	FailedVersion string `json:"failedVersion,omitempty"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 //+kubebuilder:printcolumn:JSONPath=".status.status",name="status",type="string"
@@ -39,6 +39,7 @@
 package v4
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -70,17 +71,32 @@ type SingleInstanceDatabaseSpec struct {
 	EnableTCPS            bool              `json:"enableTCPS,omitempty"`
 	TcpsCertRenewInterval string            `json:"tcpsCertRenewInterval,omitempty"`
 	TcpsTlsSecret         string            `json:"tcpsTlsSecret,omitempty"`
+	// cert-manager issuer to request the TCPS certificate from, in place of tcpsTlsSecret
+	TcpsCertIssuerRef *SingleInstanceDatabaseCertIssuerRef `json:"tcpsCertIssuerRef,omitempty"`
 
 	PrimaryDatabaseRef string `json:"primaryDatabaseRef,omitempty"`
-	// +kubebuilder:validation:Enum=primary;standby;clone;truecache
+	// +kubebuilder:validation:Enum=primary;standby;clone;truecache;restore
 	CreateAs             string   `json:"createAs,omitempty"`
 	ReadinessCheckPeriod int      `json:"readinessCheckPeriod,omitempty"`
 	ServiceAccountName   string   `json:"serviceAccountName,omitempty"`
 	TrueCacheServices    []string `json:"trueCacheServices,omitempty"`
 
+	RestoreFrom *SingleInstanceDatabaseRestoreSource `json:"restoreFrom,omitempty"`
+	Patching    *SingleInstanceDatabasePatching      `json:"patching,omitempty"`
+
+	// Pluggable databases created and dropped by the operator, in addition to pdbName
+	// +listType=map
+	// +listMapKey=name
+	Pdbs []SingleInstanceDatabasePdb `json:"pdbs,omitempty"`
+
 	// +k8s:openapi-gen=true
 	Replicas int `json:"replicas,omitempty"`
 
+	// Number of Active Data Guard standby databases created for read-only workloads
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9
+	ReadReplicas int `json:"readReplicas,omitempty"`
+
 	NodeSelector  map[string]string                   `json:"nodeSelector,omitempty"`
 	AdminPassword SingleInstanceDatabaseAdminPassword `json:"adminPassword,omitempty"`
 	Image         SingleInstanceDatabaseImage         `json:"image"`
@@ -88,7 +104,43 @@ type SingleInstanceDatabaseSpec struct {
 	InitParams    *SingleInstanceDatabaseInitParams   `json:"initParams,omitempty"`
 	Resources     SingleInstanceDatabaseResources     `json:"resources,omitempty"`
 
+	// Initialization parameters set with ALTER SYSTEM, in addition to initParams
+	// +listType=map
+	// +listMapKey=name
+	Parameters []SingleInstanceDatabaseParameter `json:"parameters,omitempty"`
+
+	// Merged with the affinity set by the operator to place replica and standby pods
+	Affinity    *corev1.Affinity    `json:"affinity,omitempty"`
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Pods of read replicas are spread across zones when not set
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	PriorityClassName         string                            `json:"priorityClassName,omitempty"`
+
+	// Shuts the database down and removes its pods until unset
+	Maintenance bool `json:"maintenance,omitempty"`
+
 	ConvertToSnapshotStandby bool `json:"convertToSnapshotStandby,omitempty"`
+
+	// Converts the snapshot standby back to a physical standby after this duration, for example 4h or 90m
+	// +kubebuilder:validation:Pattern=`^([0-9]+(s|m|h))+$`
+	SnapshotStandbyTTL string `json:"snapshotStandbyTTL,omitempty"`
+	// Guaranteed restore point created before the conversion to snapshot standby, dropped after the conversion back
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]{0,127}$`
+	SnapshotRestorePoint string `json:"snapshotRestorePoint,omitempty"`
+}
+
+// Snapshot standby conversion of the database
+type SingleInstanceDatabaseSnapshotStandbyStatus struct {
+	ConvertedAt     *metav1.Time `json:"convertedAt,omitempty"`
+	RevertAt        *metav1.Time `json:"revertAt,omitempty"`
+	RestorePoint    string       `json:"restorePoint,omitempty"`
+	RestorePointScn string       `json:"restorePointScn,omitempty"`
+	// Set when the snapshot standby was converted back after its TTL, the database stays a physical standby until the conversion is requested again
+	TTLExpired bool `json:"ttlExpired,omitempty"`
+	// Redo received while the database was a snapshot standby, applied after the last conversion back to physical standby
+	LastRevertedAt        *metav1.Time `json:"lastRevertedAt,omitempty"`
+	ReappliedArchivedLogs int          `json:"reappliedArchivedLogs,omitempty"`
+	ReappliedRedoBytes    int64        `json:"reappliedRedoBytes,omitempty"`
 }
 
 type SingleInstanceDatabaseResource struct {
@@ -111,6 +163,78 @@ type SingleInstanceDatabasePersistence struct {
 	ScriptsVolumeName     string `json:"scriptsVolumeName,omitempty"`
 	VolumeClaimAnnotation string `json:"volumeClaimAnnotation,omitempty"`
 	SetWritePermissions   *bool  `json:"setWritePermissions,omitempty"`
+	// Name of an existing PersistentVolumeClaim mounted at /opt/oracle/backup for RMAN backups
+	BackupVolumeClaimName string `json:"backupVolumeClaimName,omitempty"`
+}
+
+// SingleInstanceDatabaseRestoreSource defines the backup and recovery target of a restored database
+type SingleInstanceDatabaseRestoreSource struct {
+	// Name of a SingleInstanceDatabaseBackup in the same namespace
+	BackupRef string `json:"backupRef"`
+	// RMAN tag of the backup to restore, defaults to the last successful backup
+	Tag string `json:"tag,omitempty"`
+	// Recover until this SCN
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	Scn string `json:"scn,omitempty"`
+	// Recover until this time, in YYYY-MM-DD HH24:MI:SS format
+	// +kubebuilder:validation:Pattern=`^[0-9]{4}-[0-9]{2}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2}$`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// SingleInstanceDatabasePatching defines how image changes are rolled out to a primary database with standbys
+type SingleInstanceDatabasePatching struct {
+	// standbyFirst patches the standby databases, switches over to a patched standby and then patches this database
+	// +kubebuilder:validation:Enum=inPlace;standbyFirst
+	// +kubebuilder:default:=inPlace
+	Strategy string `json:"strategy,omitempty"`
+	// Switch back to this database after it is patched
+	SwitchBack bool `json:"switchBack,omitempty"`
+}
+
+// SingleInstanceDatabaseRollingPatchStatus defines the progress of a standby-first patch
+type SingleInstanceDatabaseRollingPatchStatus struct {
+	Phase     string `json:"phase,omitempty"`
+	Image     string `json:"image,omitempty"`
+	Version   string `json:"version,omitempty"`
+	FromImage string `json:"fromImage,omitempty"`
+	// Sid of the standby database switched over to
+	SwitchoverTarget string `json:"switchoverTarget,omitempty"`
+}
+
+// SingleInstanceDatabaseCertIssuerRef refers to the cert-manager Issuer or ClusterIssuer signing the TCPS certificate
+type SingleInstanceDatabaseCertIssuerRef struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	// +kubebuilder:default:=Issuer
+	Kind string `json:"kind,omitempty"`
+	// +kubebuilder:default:="cert-manager.io"
+	Group string `json:"group,omitempty"`
+	// Requested certificate lifetime, cert-manager renews the certificate before it expires
+	Duration string `json:"duration,omitempty"`
+}
+
+// SingleInstanceDatabasePdb defines a pluggable database managed by the operator
+type SingleInstanceDatabasePdb struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]{0,29}$`
+	Name string `json:"name"`
+	// Name of a pluggable database to clone, the PDB is created from the seed when not set
+	CloneFrom string `json:"cloneFrom,omitempty"`
+	// Administrator of a PDB created from the seed, identified by the database admin password
+	// +kubebuilder:default:="PDBADMIN"
+	AdminName string `json:"adminName,omitempty"`
+	// Delete drops the PDB and its datafiles once it is removed from pdbs, Retain leaves it in the database
+	// +kubebuilder:validation:Enum=Retain;Delete
+	// +kubebuilder:default:=Retain
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+}
+
+// SingleInstanceDatabasePdbStatus defines the observed state of a managed pluggable database
+type SingleInstanceDatabasePdbStatus struct {
+	Name              string `json:"name"`
+	OpenMode          string `json:"openMode,omitempty"`
+	ConnectString     string `json:"connectString,omitempty"`
+	TcpsConnectString string `json:"tcpsConnectString,omitempty"`
+	DeletionPolicy    string `json:"deletionPolicy,omitempty"`
 }
 
 // SingleInstanceDatabaseInitParams defines the Init Parameters
@@ -121,6 +245,30 @@ type SingleInstanceDatabaseInitParams struct {
 	Processes          int `json:"processes,omitempty"`
 }
 
+// SingleInstanceDatabaseParameter defines an initialization parameter and the scope it is set with
+type SingleInstanceDatabaseParameter struct {
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9_]*$`
+	Name string `json:"name"`
+	// Value as written in ALTER SYSTEM SET, string values must be quoted
+	// +kubebuilder:validation:Pattern=`^[^;\n]+$`
+	Value string `json:"value"`
+	// both restarts the database when the parameter is static
+	// +kubebuilder:validation:Enum=memory;spfile;both
+	// +kubebuilder:default:=both
+	Scope string `json:"scope,omitempty"`
+}
+
+// SingleInstanceDatabaseParameterStatus defines the applied and effective value of an initialization parameter
+type SingleInstanceDatabaseParameterStatus struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+	Scope string `json:"scope,omitempty"`
+	// Value read back from v$parameter
+	EffectiveValue string `json:"effectiveValue,omitempty"`
+	// The spfile value takes effect at the next database restart
+	PendingRestart bool `json:"pendingRestart,omitempty"`
+}
+
 // SingleInstanceDatabaseImage defines the Image source and pullSecrets for POD
 type SingleInstanceDatabaseImage struct {
 	Version     string `json:"version,omitempty"`
@@ -180,6 +328,8 @@ type SingleInstanceDatabaseStatus struct {
 	PrimaryDatabase       string `json:"primaryDatabase,omitempty"`
 	// +kubebuilder:default:=""
 	TcpsTlsSecret string `json:"tcpsTlsSecret"`
+	// Checksum of the TCPS certificate imported into the wallet
+	TcpsCertChecksum string `json:"tcpsCertChecksum,omitempty"`
 
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -189,8 +339,23 @@ type SingleInstanceDatabaseStatus struct {
 
 	InitParams  SingleInstanceDatabaseInitParams  `json:"initParams,omitempty"`
 	Persistence SingleInstanceDatabasePersistence `json:"persistence"`
+	// Capacity of the datafiles volume reported by the bound persistent volume claim
+	DatafilesVolumeCapacity string `json:"datafilesVolumeCapacity,omitempty"`
 
-	ConvertToSnapshotStandby bool `json:"convertToSnapshotStandby,omitempty"`
+	ConvertToSnapshotStandby bool                                         `json:"convertToSnapshotStandby,omitempty"`
+	SnapshotStandby          *SingleInstanceDatabaseSnapshotStandbyStatus `json:"snapshotStandby,omitempty"`
+
+	RollingPatch *SingleInstanceDatabaseRollingPatchStatus `json:"rollingPatch,omitempty"`
+
+	Parameters []SingleInstanceDatabaseParameterStatus `json:"parameters,omitempty"`
+	// Startup time of the instance the parameters were read from
+	InstanceStartupTime string `json:"instanceStartupTime,omitempty"`
+
+	Pdbs []SingleInstanceDatabasePdbStatus `json:"pdbs,omitempty"`
+
+	// Names of the read replica standby databases
+	ReadReplicas          []string `json:"readReplicas,omitempty"`
+	ReadOnlyConnectString string   `json:"readOnlyConnectString,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,162 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package v4
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SingleInstanceDatabaseBackupSpec defines the desired state of SingleInstanceDatabaseBackup
+type SingleInstanceDatabaseBackupSpec struct {
+	// Name of the SingleInstanceDatabase to back up
+	// +kubebuilder:validation:Required
+	SingleInstanceDatabaseRef string `json:"singleInstanceDatabaseRef"`
+
+	// Cron schedule ("min hour dom month dow" or @hourly/@daily/@weekly/@monthly).
+	// A single backup is taken when the schedule is empty.
+	Schedule string `json:"schedule,omitempty"`
+	Suspend  bool   `json:"suspend,omitempty"`
+
+	// +kubebuilder:validation:Enum=full;incremental;archivelog
+	// +kubebuilder:default:=full
+	BackupType string `json:"backupType,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	IncrementalLevel int  `json:"incrementalLevel,omitempty"`
+	Compressed       bool `json:"compressed,omitempty"`
+
+	Destination SingleInstanceDatabaseBackupDestination `json:"destination,omitempty"`
+	Retention   SingleInstanceDatabaseBackupRetention   `json:"retention,omitempty"`
+
+	// Maximum number of backup records kept in status
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default:=10
+	BackupHistoryLimit int `json:"backupHistoryLimit,omitempty"`
+}
+
+// SingleInstanceDatabaseBackupDestination defines where the backup pieces are written
+type SingleInstanceDatabaseBackupDestination struct {
+	// pvc writes to the claim referenced by the database's persistence.backupVolumeClaimName
+	// +kubebuilder:validation:Enum=pvc;s3
+	// +kubebuilder:default:=pvc
+	Type string                          `json:"type,omitempty"`
+	S3   *SingleInstanceDatabaseBackupS3 `json:"s3,omitempty"`
+}
+
+// SingleInstanceDatabaseBackupS3 defines an S3 compatible object storage endpoint
+type SingleInstanceDatabaseBackupS3 struct {
+	// Endpoint host, e.g. <namespace>.compat.objectstorage.<region>.oraclecloud.com
+	Endpoint string `json:"endpoint"`
+	Bucket   string `json:"bucket"`
+	Region   string `json:"region,omitempty"`
+	// Secret with keys accessKeyId and secretAccessKey
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// SingleInstanceDatabaseBackupRetention defines the RMAN retention policy
+type SingleInstanceDatabaseBackupRetention struct {
+	RecoveryWindowDays int `json:"recoveryWindowDays,omitempty"`
+	Redundancy         int `json:"redundancy,omitempty"`
+}
+
+// SingleInstanceDatabaseBackupRecord describes a completed or running RMAN backup
+type SingleInstanceDatabaseBackupRecord struct {
+	Tag            string       `json:"tag"`
+	BackupType     string       `json:"backupType,omitempty"`
+	Status         string       `json:"status,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Highest checkpoint (or next change for archivelogs) SCN covered by the backup
+	Scn              string   `json:"scn,omitempty"`
+	Pieces           []string `json:"pieces,omitempty"`
+	ControlFilePiece string   `json:"controlFilePiece,omitempty"`
+}
+
+// SingleInstanceDatabaseBackupStatus defines the observed state of SingleInstanceDatabaseBackup
+type SingleInstanceDatabaseBackupStatus struct {
+	Status            string       `json:"status,omitempty"`
+	Sid               string       `json:"sid,omitempty"`
+	DbId              string       `json:"dbId,omitempty"`
+	LastScheduleTime  *metav1.Time `json:"lastScheduleTime,omitempty"`
+	NextScheduleTime  *metav1.Time `json:"nextScheduleTime,omitempty"`
+	LastSuccessfulTag string       `json:"lastSuccessfulTag,omitempty"`
+	// Tag of the backup currently running in the database pod
+	RunningTag string `json:"runningTag,omitempty"`
+
+	Backups []SingleInstanceDatabaseBackupRecord `json:"backups,omitempty"`
+
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=sidbbackup;sidbbackups
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:JSONPath=".spec.singleInstanceDatabaseRef",name="Database",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.backupType",name="Type",type="string"
+// +kubebuilder:printcolumn:JSONPath=".spec.schedule",name="Schedule",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.status",name="Status",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.lastSuccessfulTag",name="Last Backup",type="string"
+// +kubebuilder:printcolumn:JSONPath=".status.nextScheduleTime",name="Next",type="date",priority=1
+
+// SingleInstanceDatabaseBackup is the Schema for the singleinstancedatabasebackups API
+// +kubebuilder:storageversion
+type SingleInstanceDatabaseBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SingleInstanceDatabaseBackupSpec   `json:"spec,omitempty"`
+	Status SingleInstanceDatabaseBackupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// SingleInstanceDatabaseBackupList contains a list of SingleInstanceDatabaseBackup
+type SingleInstanceDatabaseBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SingleInstanceDatabaseBackup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SingleInstanceDatabaseBackup{}, &SingleInstanceDatabaseBackupList{})
+}
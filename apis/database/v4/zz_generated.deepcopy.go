@@ -46,6 +46,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -807,6 +808,21 @@ func (in *Backupconfig) DeepCopy() *Backupconfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CACertificateSecret) DeepCopyInto(out *CACertificateSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CACertificateSecret.
+func (in *CACertificateSecret) DeepCopy() *CACertificateSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(CACertificateSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CatalogSpec) DeepCopyInto(out *CatalogSpec) {
 	*out = *in
@@ -1126,7 +1142,7 @@ func (in *DataGuardStatus) DeepCopy() *DataGuardStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataguardBroker) DeepCopyInto(out *DataguardBroker) {
+func (in *DatabaseUser) DeepCopyInto(out *DatabaseUser) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1134,18 +1150,18 @@ func (in *DataguardBroker) DeepCopyInto(out *DataguardBroker) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBroker.
-func (in *DataguardBroker) DeepCopy() *DataguardBroker {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUser.
+func (in *DatabaseUser) DeepCopy() *DatabaseUser {
 	if in == nil {
 		return nil
 	}
-	out := new(DataguardBroker)
+	out := new(DatabaseUser)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataguardBroker) DeepCopyObject() runtime.Object {
+func (in *DatabaseUser) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1153,31 +1169,31 @@ func (in *DataguardBroker) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataguardBrokerList) DeepCopyInto(out *DataguardBrokerList) {
+func (in *DatabaseUserList) DeepCopyInto(out *DatabaseUserList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DataguardBroker, len(*in))
+		*out = make([]DatabaseUser, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerList.
-func (in *DataguardBrokerList) DeepCopy() *DataguardBrokerList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserList.
+func (in *DatabaseUserList) DeepCopy() *DatabaseUserList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataguardBrokerList)
+	out := new(DatabaseUserList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataguardBrokerList) DeepCopyObject() runtime.Object {
+func (in *DatabaseUserList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1185,224 +1201,260 @@ func (in *DataguardBrokerList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataguardBrokerSpec) DeepCopyInto(out *DataguardBrokerSpec) {
+func (in *DatabaseUserObjectGrant) DeepCopyInto(out *DatabaseUserObjectGrant) {
 	*out = *in
-	if in.StandbyDatabaseRefs != nil {
-		in, out := &in.StandbyDatabaseRefs, &out.StandbyDatabaseRefs
-		*out = make([]string, len(*in))
+	if in.Privileges != nil {
+		in, out := &in.Privileges, &out.Privileges
+		*out = make([]DatabaseUserPriv, len(*in))
 		copy(*out, *in)
 	}
-	if in.ServiceAnnotations != nil {
-		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerSpec.
-func (in *DataguardBrokerSpec) DeepCopy() *DataguardBrokerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserObjectGrant.
+func (in *DatabaseUserObjectGrant) DeepCopy() *DatabaseUserObjectGrant {
 	if in == nil {
 		return nil
 	}
-	out := new(DataguardBrokerSpec)
+	out := new(DatabaseUserObjectGrant)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataguardBrokerStatus) DeepCopyInto(out *DataguardBrokerStatus) {
+func (in *DatabaseUserPasswordSecret) DeepCopyInto(out *DatabaseUserPasswordSecret) {
 	*out = *in
-	if in.DatabasesInDataguardConfig != nil {
-		in, out := &in.DatabasesInDataguardConfig, &out.DatabasesInDataguardConfig
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserPasswordSecret.
+func (in *DatabaseUserPasswordSecret) DeepCopy() *DatabaseUserPasswordSecret {
+	if in == nil {
+		return nil
 	}
+	out := new(DatabaseUserPasswordSecret)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStatus.
-func (in *DataguardBrokerStatus) DeepCopy() *DataguardBrokerStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseUserQuota) DeepCopyInto(out *DatabaseUserQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserQuota.
+func (in *DatabaseUserQuota) DeepCopy() *DatabaseUserQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(DataguardBrokerStatus)
+	out := new(DatabaseUserQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbCloneConfig) DeepCopyInto(out *DbCloneConfig) {
+func (in *DatabaseUserSpec) DeepCopyInto(out *DatabaseUserSpec) {
 	*out = *in
-	if in.SshPublicKeys != nil {
-		in, out := &in.SshPublicKeys, &out.SshPublicKeys
-		*out = make([]string, len(*in))
+	out.PasswordSecret = in.PasswordSecret
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]DatabaseUserQuota, len(*in))
+		copy(*out, *in)
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]DatabaseUserRole, len(*in))
+		copy(*out, *in)
+	}
+	if in.SystemPrivileges != nil {
+		in, out := &in.SystemPrivileges, &out.SystemPrivileges
+		*out = make([]DatabaseUserPriv, len(*in))
 		copy(*out, *in)
 	}
+	if in.ObjectGrants != nil {
+		in, out := &in.ObjectGrants, &out.ObjectGrants
+		*out = make([]DatabaseUserObjectGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbCloneConfig.
-func (in *DbCloneConfig) DeepCopy() *DbCloneConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserSpec.
+func (in *DatabaseUserSpec) DeepCopy() *DatabaseUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DbCloneConfig)
+	out := new(DatabaseUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbCloneStatus) DeepCopyInto(out *DbCloneStatus) {
+func (in *DatabaseUserStatus) DeepCopyInto(out *DatabaseUserStatus) {
 	*out = *in
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
-		*out = new(string)
-		**out = **in
+	if in.GrantedRoles != nil {
+		in, out := &in.GrantedRoles, &out.GrantedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.SshPublicKeys != nil {
-		in, out := &in.SshPublicKeys, &out.SshPublicKeys
+	if in.GrantedSystemPrivileges != nil {
+		in, out := &in.GrantedSystemPrivileges, &out.GrantedSystemPrivileges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GrantedObjectPrivileges != nil {
+		in, out := &in.GrantedObjectPrivileges, &out.GrantedObjectPrivileges
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbCloneStatus.
-func (in *DbCloneStatus) DeepCopy() *DbCloneStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseUserStatus.
+func (in *DatabaseUserStatus) DeepCopy() *DatabaseUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DbCloneStatus)
+	out := new(DatabaseUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbStatus) DeepCopyInto(out *DbStatus) {
+func (in *DataguardBroker) DeepCopyInto(out *DataguardBroker) {
 	*out = *in
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBroker.
+func (in *DataguardBroker) DeepCopy() *DataguardBroker {
+	if in == nil {
+		return nil
 	}
+	out := new(DataguardBroker)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbStatus.
-func (in *DbStatus) DeepCopy() *DbStatus {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataguardBroker) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerExternalDatabase) DeepCopyInto(out *DataguardBrokerExternalDatabase) {
+	*out = *in
+	out.AdminPassword = in.AdminPassword
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerExternalDatabase.
+func (in *DataguardBrokerExternalDatabase) DeepCopy() *DataguardBrokerExternalDatabase {
 	if in == nil {
 		return nil
 	}
-	out := new(DbStatus)
+	out := new(DataguardBrokerExternalDatabase)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbSystemDetails) DeepCopyInto(out *DbSystemDetails) {
+func (in *DataguardBrokerExternalDatabasePassword) DeepCopyInto(out *DataguardBrokerExternalDatabasePassword) {
 	*out = *in
-	if in.SshPublicKeys != nil {
-		in, out := &in.SshPublicKeys, &out.SshPublicKeys
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerExternalDatabasePassword.
+func (in *DataguardBrokerExternalDatabasePassword) DeepCopy() *DataguardBrokerExternalDatabasePassword {
+	if in == nil {
+		return nil
 	}
-	if in.FaultDomains != nil {
-		in, out := &in.FaultDomains, &out.FaultDomains
+	out := new(DataguardBrokerExternalDatabasePassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerFarSync) DeepCopyInto(out *DataguardBrokerFarSync) {
+	*out = *in
+	if in.StandbyDatabaseRefs != nil {
+		in, out := &in.StandbyDatabaseRefs, &out.StandbyDatabaseRefs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.NodeCount != nil {
-		in, out := &in.NodeCount, &out.NodeCount
-		*out = new(int)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.DbBackupConfig != nil {
-		in, out := &in.DbBackupConfig, &out.DbBackupConfig
-		*out = new(Backupconfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KMSConfig != nil {
-		in, out := &in.KMSConfig, &out.KMSConfig
-		*out = new(KMSConfig)
-		**out = **in
-	}
-	if in.RestoreConfig != nil {
-		in, out := &in.RestoreConfig, &out.RestoreConfig
-		*out = new(RestoreConfig)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbSystemDetails.
-func (in *DbSystemDetails) DeepCopy() *DbSystemDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerFarSync.
+func (in *DataguardBrokerFarSync) DeepCopy() *DataguardBrokerFarSync {
 	if in == nil {
 		return nil
 	}
-	out := new(DbSystemDetails)
+	out := new(DataguardBrokerFarSync)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbWorkrequests) DeepCopyInto(out *DbWorkrequests) {
+func (in *DataguardBrokerFarSyncStatus) DeepCopyInto(out *DataguardBrokerFarSyncStatus) {
 	*out = *in
-	if in.OperationType != nil {
-		in, out := &in.OperationType, &out.OperationType
-		*out = new(string)
-		**out = **in
-	}
-	if in.OperationId != nil {
-		in, out := &in.OperationId, &out.OperationId
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbWorkrequests.
-func (in *DbWorkrequests) DeepCopy() *DbWorkrequests {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerFarSyncStatus.
+func (in *DataguardBrokerFarSyncStatus) DeepCopy() *DataguardBrokerFarSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DbWorkrequests)
+	out := new(DataguardBrokerFarSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbcsSystem) DeepCopyInto(out *DbcsSystem) {
+func (in *DataguardBrokerList) DeepCopyInto(out *DataguardBrokerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataguardBroker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbcsSystem.
-func (in *DbcsSystem) DeepCopy() *DbcsSystem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerList.
+func (in *DataguardBrokerList) DeepCopy() *DataguardBrokerList {
 	if in == nil {
 		return nil
 	}
-	out := new(DbcsSystem)
+	out := new(DataguardBrokerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DbcsSystem) DeepCopyObject() runtime.Object {
+func (in *DataguardBrokerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1410,48 +1462,517 @@ func (in *DbcsSystem) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbcsSystemList) DeepCopyInto(out *DbcsSystemList) {
+func (in *DataguardBrokerObserverPlacement) DeepCopyInto(out *DataguardBrokerObserverPlacement) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]DbcsSystem, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbcsSystemList.
-func (in *DbcsSystemList) DeepCopy() *DbcsSystemList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerObserverPlacement.
+func (in *DataguardBrokerObserverPlacement) DeepCopy() *DataguardBrokerObserverPlacement {
 	if in == nil {
 		return nil
 	}
-	out := new(DbcsSystemList)
+	out := new(DataguardBrokerObserverPlacement)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DbcsSystemList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerObserverStatus) DeepCopyInto(out *DataguardBrokerObserverStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerObserverStatus.
+func (in *DataguardBrokerObserverStatus) DeepCopy() *DataguardBrokerObserverStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DataguardBrokerObserverStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DbcsSystemSpec) DeepCopyInto(out *DbcsSystemSpec) {
+func (in *DataguardBrokerSpec) DeepCopyInto(out *DataguardBrokerSpec) {
 	*out = *in
-	if in.DbSystem != nil {
-		in, out := &in.DbSystem, &out.DbSystem
-		*out = new(DbSystemDetails)
-		(*in).DeepCopyInto(*out)
+	if in.StandbyDatabaseRefs != nil {
+		in, out := &in.StandbyDatabaseRefs, &out.StandbyDatabaseRefs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.Id != nil {
-		in, out := &in.Id, &out.Id
-		*out = new(string)
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ObserverPlacement != nil {
+		in, out := &in.ObserverPlacement, &out.ObserverPlacement
+		*out = new(DataguardBrokerObserverPlacement)
+		**out = **in
+	}
+	if in.StandbySettings != nil {
+		in, out := &in.StandbySettings, &out.StandbySettings
+		*out = make([]DataguardBrokerStandbySettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FarSync != nil {
+		in, out := &in.FarSync, &out.FarSync
+		*out = new(DataguardBrokerFarSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalDatabases != nil {
+		in, out := &in.ExternalDatabases, &out.ExternalDatabases
+		*out = make([]DataguardBrokerExternalDatabase, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerSpec.
+func (in *DataguardBrokerSpec) DeepCopy() *DataguardBrokerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerStandbyHealth) DeepCopyInto(out *DataguardBrokerStandbyHealth) {
+	*out = *in
+	if in.TransportLagSeconds != nil {
+		in, out := &in.TransportLagSeconds, &out.TransportLagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ApplyLagSeconds != nil {
+		in, out := &in.ApplyLagSeconds, &out.ApplyLagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStandbyHealth.
+func (in *DataguardBrokerStandbyHealth) DeepCopy() *DataguardBrokerStandbyHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerStandbyHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerStandbySettings) DeepCopyInto(out *DataguardBrokerStandbySettings) {
+	*out = *in
+	if in.DelayMins != nil {
+		in, out := &in.DelayMins, &out.DelayMins
+		*out = new(int)
+		**out = **in
+	}
+	if in.ApplyLagThreshold != nil {
+		in, out := &in.ApplyLagThreshold, &out.ApplyLagThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.TransportLagThreshold != nil {
+		in, out := &in.TransportLagThreshold, &out.TransportLagThreshold
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStandbySettings.
+func (in *DataguardBrokerStandbySettings) DeepCopy() *DataguardBrokerStandbySettings {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerStandbySettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardBrokerStatus) DeepCopyInto(out *DataguardBrokerStatus) {
+	*out = *in
+	if in.DatabasesInDataguardConfig != nil {
+		in, out := &in.DatabasesInDataguardConfig, &out.DatabasesInDataguardConfig
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StandbySettings != nil {
+		in, out := &in.StandbySettings, &out.StandbySettings
+		*out = make([]DataguardBrokerStandbySettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Observers != nil {
+		in, out := &in.Observers, &out.Observers
+		*out = make([]DataguardBrokerObserverStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.FarSync != nil {
+		in, out := &in.FarSync, &out.FarSync
+		*out = new(DataguardBrokerFarSyncStatus)
+		**out = **in
+	}
+	if in.ExternalDatabases != nil {
+		in, out := &in.ExternalDatabases, &out.ExternalDatabases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StandbyHealth != nil {
+		in, out := &in.StandbyHealth, &out.StandbyHealth
+		*out = make([]DataguardBrokerStandbyHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastHealthCheck != nil {
+		in, out := &in.LastHealthCheck, &out.LastHealthCheck
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardBrokerStatus.
+func (in *DataguardBrokerStatus) DeepCopy() *DataguardBrokerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardBrokerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardOperation) DeepCopyInto(out *DataguardOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardOperation.
+func (in *DataguardOperation) DeepCopy() *DataguardOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataguardOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardOperationList) DeepCopyInto(out *DataguardOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataguardOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardOperationList.
+func (in *DataguardOperationList) DeepCopy() *DataguardOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataguardOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardOperationSpec) DeepCopyInto(out *DataguardOperationSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardOperationSpec.
+func (in *DataguardOperationSpec) DeepCopy() *DataguardOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataguardOperationStatus) DeepCopyInto(out *DataguardOperationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataguardOperationStatus.
+func (in *DataguardOperationStatus) DeepCopy() *DataguardOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataguardOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbCloneConfig) DeepCopyInto(out *DbCloneConfig) {
+	*out = *in
+	if in.SshPublicKeys != nil {
+		in, out := &in.SshPublicKeys, &out.SshPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbCloneConfig.
+func (in *DbCloneConfig) DeepCopy() *DbCloneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DbCloneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbCloneStatus) DeepCopyInto(out *DbCloneStatus) {
+	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+	if in.SshPublicKeys != nil {
+		in, out := &in.SshPublicKeys, &out.SshPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbCloneStatus.
+func (in *DbCloneStatus) DeepCopy() *DbCloneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DbCloneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbStatus) DeepCopyInto(out *DbStatus) {
+	*out = *in
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbStatus.
+func (in *DbStatus) DeepCopy() *DbStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DbStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbSystemDetails) DeepCopyInto(out *DbSystemDetails) {
+	*out = *in
+	if in.SshPublicKeys != nil {
+		in, out := &in.SshPublicKeys, &out.SshPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FaultDomains != nil {
+		in, out := &in.FaultDomains, &out.FaultDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeCount != nil {
+		in, out := &in.NodeCount, &out.NodeCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DbBackupConfig != nil {
+		in, out := &in.DbBackupConfig, &out.DbBackupConfig
+		*out = new(Backupconfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSConfig != nil {
+		in, out := &in.KMSConfig, &out.KMSConfig
+		*out = new(KMSConfig)
+		**out = **in
+	}
+	if in.RestoreConfig != nil {
+		in, out := &in.RestoreConfig, &out.RestoreConfig
+		*out = new(RestoreConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbSystemDetails.
+func (in *DbSystemDetails) DeepCopy() *DbSystemDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(DbSystemDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbWorkrequests) DeepCopyInto(out *DbWorkrequests) {
+	*out = *in
+	if in.OperationType != nil {
+		in, out := &in.OperationType, &out.OperationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.OperationId != nil {
+		in, out := &in.OperationId, &out.OperationId
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbWorkrequests.
+func (in *DbWorkrequests) DeepCopy() *DbWorkrequests {
+	if in == nil {
+		return nil
+	}
+	out := new(DbWorkrequests)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbcsSystem) DeepCopyInto(out *DbcsSystem) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbcsSystem.
+func (in *DbcsSystem) DeepCopy() *DbcsSystem {
+	if in == nil {
+		return nil
+	}
+	out := new(DbcsSystem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DbcsSystem) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbcsSystemList) DeepCopyInto(out *DbcsSystemList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DbcsSystem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DbcsSystemList.
+func (in *DbcsSystemList) DeepCopy() *DbcsSystemList {
+	if in == nil {
+		return nil
+	}
+	out := new(DbcsSystemList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DbcsSystemList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DbcsSystemSpec) DeepCopyInto(out *DbcsSystemSpec) {
+	*out = *in
+	if in.DbSystem != nil {
+		in, out := &in.DbSystem, &out.DbSystem
+		*out = new(DbSystemDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Id != nil {
+		in, out := &in.Id, &out.Id
+		*out = new(string)
 		**out = **in
 	}
 	if in.OCIConfigMap != nil {
@@ -1884,6 +2405,31 @@ func (in *GsmStatusDetails) DeepCopy() *GsmStatusDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HashiCorpVault) DeepCopyInto(out *HashiCorpVault) {
+	*out = *in
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(CACertificateSecret)
+		**out = **in
+	}
+	if in.TokenSecret != nil {
+		in, out := &in.TokenSecret, &out.TokenSecret
+		*out = new(PasswordSecret)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HashiCorpVault.
+func (in *HashiCorpVault) DeepCopy() *HashiCorpVault {
+	if in == nil {
+		return nil
+	}
+	out := new(HashiCorpVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitParams) DeepCopyInto(out *InitParams) {
 	*out = *in
@@ -3264,54 +3810,249 @@ func (in *OrdsSrvs) DeepCopyInto(out *OrdsSrvs) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvs.
-func (in *OrdsSrvs) DeepCopy() *OrdsSrvs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvs.
+func (in *OrdsSrvs) DeepCopy() *OrdsSrvs {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrdsSrvs) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsAutoscaling) DeepCopyInto(out *OrdsSrvsAutoscaling) {
+	*out = *in
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]OrdsSrvsAutoscalingMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsAutoscaling.
+func (in *OrdsSrvsAutoscaling) DeepCopy() *OrdsSrvsAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsAutoscalingMetric) DeepCopyInto(out *OrdsSrvsAutoscalingMetric) {
+	*out = *in
+	out.TargetAverageValue = in.TargetAverageValue.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsAutoscalingMetric.
+func (in *OrdsSrvsAutoscalingMetric) DeepCopy() *OrdsSrvsAutoscalingMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsAutoscalingMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsConfigRolloutStatus) DeepCopyInto(out *OrdsSrvsConfigRolloutStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsConfigRolloutStatus.
+func (in *OrdsSrvsConfigRolloutStatus) DeepCopy() *OrdsSrvsConfigRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsConfigRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsGatewayRef) DeepCopyInto(out *OrdsSrvsGatewayRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsGatewayRef.
+func (in *OrdsSrvsGatewayRef) DeepCopy() *OrdsSrvsGatewayRef {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsGatewayRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsHTTPRoute) DeepCopyInto(out *OrdsSrvsHTTPRoute) {
+	*out = *in
+	if in.ParentRefs != nil {
+		in, out := &in.ParentRefs, &out.ParentRefs
+		*out = make([]OrdsSrvsGatewayRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hostnames != nil {
+		in, out := &in.Hostnames, &out.Hostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsHTTPRoute.
+func (in *OrdsSrvsHTTPRoute) DeepCopy() *OrdsSrvsHTTPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsHTTPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsIngress) DeepCopyInto(out *OrdsSrvsIngress) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsIngress.
+func (in *OrdsSrvsIngress) DeepCopy() *OrdsSrvsIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsList) DeepCopyInto(out *OrdsSrvsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrdsSrvs, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsList.
+func (in *OrdsSrvsList) DeepCopy() *OrdsSrvsList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrdsSrvsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsPodDisruptionBudget) DeepCopyInto(out *OrdsSrvsPodDisruptionBudget) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsPodDisruptionBudget.
+func (in *OrdsSrvsPodDisruptionBudget) DeepCopy() *OrdsSrvsPodDisruptionBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdsSrvs)
+	out := new(OrdsSrvsPodDisruptionBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrdsSrvs) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrdsSrvsList) DeepCopyInto(out *OrdsSrvsList) {
+func (in *OrdsSrvsPoolStatus) DeepCopyInto(out *OrdsSrvsPoolStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]OrdsSrvs, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsList.
-func (in *OrdsSrvsList) DeepCopy() *OrdsSrvsList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsPoolStatus.
+func (in *OrdsSrvsPoolStatus) DeepCopy() *OrdsSrvsPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrdsSrvsList)
+	out := new(OrdsSrvsPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrdsSrvsList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsRollingConfigUpdate) DeepCopyInto(out *OrdsSrvsRollingConfigUpdate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsRollingConfigUpdate.
+func (in *OrdsSrvsRollingConfigUpdate) DeepCopy() *OrdsSrvsRollingConfigUpdate {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(OrdsSrvsRollingConfigUpdate)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -3330,6 +4071,46 @@ func (in *OrdsSrvsSpec) DeepCopyInto(out *OrdsSrvsSpec) {
 			}
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(OrdsSrvsAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(OrdsSrvsPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(OrdsSrvsIngress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPRoute != nil {
+		in, out := &in.HTTPRoute, &out.HTTPRoute
+		*out = new(OrdsSrvsHTTPRoute)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoolHealthCheckInterval != nil {
+		in, out := &in.PoolHealthCheckInterval, &out.PoolHealthCheckInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(OrdsSrvsVault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingConfigUpdate != nil {
+		in, out := &in.RollingConfigUpdate, &out.RollingConfigUpdate
+		*out = new(OrdsSrvsRollingConfigUpdate)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsSpec.
@@ -3355,6 +4136,18 @@ func (in *OrdsSrvsStatus) DeepCopyInto(out *OrdsSrvsStatus) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]OrdsSrvsPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ConfigRollout != nil {
+		in, out := &in.ConfigRollout, &out.ConfigRollout
+		*out = new(OrdsSrvsConfigRolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -3374,6 +4167,27 @@ func (in *OrdsSrvsStatus) DeepCopy() *OrdsSrvsStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrdsSrvsVault) DeepCopyInto(out *OrdsSrvsVault) {
+	*out = *in
+	in.OciConfig.DeepCopyInto(&out.OciConfig)
+	if in.HashiCorp != nil {
+		in, out := &in.HashiCorp, &out.HashiCorp
+		*out = new(HashiCorpVault)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrdsSrvsVault.
+func (in *OrdsSrvsVault) DeepCopy() *OrdsSrvsVault {
+	if in == nil {
+		return nil
+	}
+	out := new(OrdsSrvsVault)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PDBConfig) DeepCopyInto(out *PDBConfig) {
 	*out = *in
@@ -3558,6 +4372,11 @@ func (in *PoolSettings) DeepCopyInto(out *PoolSettings) {
 	out.DBSecret = in.DBSecret
 	out.DBAdminUserSecret = in.DBAdminUserSecret
 	out.DBCDBAdminUserSecret = in.DBCDBAdminUserSecret
+	if in.VaultSecrets != nil {
+		in, out := &in.VaultSecrets, &out.VaultSecrets
+		*out = new(PoolVaultSecrets)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DebugTrackResources != nil {
 		in, out := &in.DebugTrackResources, &out.DebugTrackResources
 		*out = new(bool)
@@ -3670,6 +4489,36 @@ func (in *PoolSettings) DeepCopy() *PoolSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PoolVaultSecrets) DeepCopyInto(out *PoolVaultSecrets) {
+	*out = *in
+	if in.DBPassword != nil {
+		in, out := &in.DBPassword, &out.DBPassword
+		*out = new(VaultSecret)
+		**out = **in
+	}
+	if in.DBAdminUserPassword != nil {
+		in, out := &in.DBAdminUserPassword, &out.DBAdminUserPassword
+		*out = new(VaultSecret)
+		**out = **in
+	}
+	if in.DBCDBAdminUserPassword != nil {
+		in, out := &in.DBCDBAdminUserPassword, &out.DBCDBAdminUserPassword
+		*out = new(VaultSecret)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PoolVaultSecrets.
+func (in *PoolVaultSecrets) DeepCopy() *PoolVaultSecrets {
+	if in == nil {
+		return nil
+	}
+	out := new(PoolVaultSecrets)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PortMapping) DeepCopyInto(out *PortMapping) {
 	*out = *in
@@ -4650,130 +5499,336 @@ func (in *ShardingDatabaseSpec) DeepCopyInto(out *ShardingDatabaseSpec) {
 		*out = new(SecretDetails)
 		**out = **in
 	}
-	if in.ShardInfo != nil {
-		in, out := &in.ShardInfo, &out.ShardInfo
-		*out = make([]ShardingDetails, len(*in))
+	if in.ShardInfo != nil {
+		in, out := &in.ShardInfo, &out.ShardInfo
+		*out = make([]ShardingDetails, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDatabaseSpec.
+func (in *ShardingDatabaseSpec) DeepCopy() *ShardingDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingDatabaseStatus) DeepCopyInto(out *ShardingDatabaseStatus) {
+	*out = *in
+	if in.Shard != nil {
+		in, out := &in.Shard, &out.Shard
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Catalog != nil {
+		in, out := &in.Catalog, &out.Catalog
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Gsm.DeepCopyInto(&out.Gsm)
+	if in.CrdStatus != nil {
+		in, out := &in.CrdStatus, &out.CrdStatus
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDatabaseStatus.
+func (in *ShardingDatabaseStatus) DeepCopy() *ShardingDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingDetails) DeepCopyInto(out *ShardingDetails) {
+	*out = *in
+	if in.ShardGroupDetails != nil {
+		in, out := &in.ShardGroupDetails, &out.ShardGroupDetails
+		*out = new(ShardGroup)
+		**out = **in
+	}
+	if in.ShardSpaceDetails != nil {
+		in, out := &in.ShardSpaceDetails, &out.ShardSpaceDetails
+		*out = new(ShardSpace)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDetails.
+func (in *ShardingDetails) DeepCopy() *ShardingDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabase) DeepCopyInto(out *SingleInstanceDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabase.
+func (in *SingleInstanceDatabase) DeepCopy() *SingleInstanceDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SingleInstanceDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseAdminPassword) DeepCopyInto(out *SingleInstanceDatabaseAdminPassword) {
+	*out = *in
+	if in.KeepSecret != nil {
+		in, out := &in.KeepSecret, &out.KeepSecret
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseAdminPassword.
+func (in *SingleInstanceDatabaseAdminPassword) DeepCopy() *SingleInstanceDatabaseAdminPassword {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseAdminPassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseBackup) DeepCopyInto(out *SingleInstanceDatabaseBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackup.
+func (in *SingleInstanceDatabaseBackup) DeepCopy() *SingleInstanceDatabaseBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SingleInstanceDatabaseBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseBackupDestination) DeepCopyInto(out *SingleInstanceDatabaseBackupDestination) {
+	*out = *in
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(SingleInstanceDatabaseBackupS3)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupDestination.
+func (in *SingleInstanceDatabaseBackupDestination) DeepCopy() *SingleInstanceDatabaseBackupDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseBackupDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseBackupList) DeepCopyInto(out *SingleInstanceDatabaseBackupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SingleInstanceDatabaseBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDatabaseSpec.
-func (in *ShardingDatabaseSpec) DeepCopy() *ShardingDatabaseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupList.
+func (in *SingleInstanceDatabaseBackupList) DeepCopy() *SingleInstanceDatabaseBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(ShardingDatabaseSpec)
+	out := new(SingleInstanceDatabaseBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SingleInstanceDatabaseBackupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShardingDatabaseStatus) DeepCopyInto(out *ShardingDatabaseStatus) {
+func (in *SingleInstanceDatabaseBackupRecord) DeepCopyInto(out *SingleInstanceDatabaseBackupRecord) {
 	*out = *in
-	if in.Shard != nil {
-		in, out := &in.Shard, &out.Shard
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Catalog != nil {
-		in, out := &in.Catalog, &out.Catalog
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	in.Gsm.DeepCopyInto(&out.Gsm)
-	if in.CrdStatus != nil {
-		in, out := &in.CrdStatus, &out.CrdStatus
-		*out = make([]v1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Pieces != nil {
+		in, out := &in.Pieces, &out.Pieces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDatabaseStatus.
-func (in *ShardingDatabaseStatus) DeepCopy() *ShardingDatabaseStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupRecord.
+func (in *SingleInstanceDatabaseBackupRecord) DeepCopy() *SingleInstanceDatabaseBackupRecord {
 	if in == nil {
 		return nil
 	}
-	out := new(ShardingDatabaseStatus)
+	out := new(SingleInstanceDatabaseBackupRecord)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShardingDetails) DeepCopyInto(out *ShardingDetails) {
+func (in *SingleInstanceDatabaseBackupRetention) DeepCopyInto(out *SingleInstanceDatabaseBackupRetention) {
 	*out = *in
-	if in.ShardGroupDetails != nil {
-		in, out := &in.ShardGroupDetails, &out.ShardGroupDetails
-		*out = new(ShardGroup)
-		**out = **in
-	}
-	if in.ShardSpaceDetails != nil {
-		in, out := &in.ShardSpaceDetails, &out.ShardSpaceDetails
-		*out = new(ShardSpace)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingDetails.
-func (in *ShardingDetails) DeepCopy() *ShardingDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupRetention.
+func (in *SingleInstanceDatabaseBackupRetention) DeepCopy() *SingleInstanceDatabaseBackupRetention {
 	if in == nil {
 		return nil
 	}
-	out := new(ShardingDetails)
+	out := new(SingleInstanceDatabaseBackupRetention)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SingleInstanceDatabase) DeepCopyInto(out *SingleInstanceDatabase) {
+func (in *SingleInstanceDatabaseBackupS3) DeepCopyInto(out *SingleInstanceDatabaseBackupS3) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabase.
-func (in *SingleInstanceDatabase) DeepCopy() *SingleInstanceDatabase {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupS3.
+func (in *SingleInstanceDatabaseBackupS3) DeepCopy() *SingleInstanceDatabaseBackupS3 {
 	if in == nil {
 		return nil
 	}
-	out := new(SingleInstanceDatabase)
+	out := new(SingleInstanceDatabaseBackupS3)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SingleInstanceDatabase) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseBackupSpec) DeepCopyInto(out *SingleInstanceDatabaseBackupSpec) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	out.Retention = in.Retention
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupSpec.
+func (in *SingleInstanceDatabaseBackupSpec) DeepCopy() *SingleInstanceDatabaseBackupSpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(SingleInstanceDatabaseBackupSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SingleInstanceDatabaseAdminPassword) DeepCopyInto(out *SingleInstanceDatabaseAdminPassword) {
+func (in *SingleInstanceDatabaseBackupStatus) DeepCopyInto(out *SingleInstanceDatabaseBackupStatus) {
 	*out = *in
-	if in.KeepSecret != nil {
-		in, out := &in.KeepSecret, &out.KeepSecret
-		*out = new(bool)
-		**out = **in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Backups != nil {
+		in, out := &in.Backups, &out.Backups
+		*out = make([]SingleInstanceDatabaseBackupRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseAdminPassword.
-func (in *SingleInstanceDatabaseAdminPassword) DeepCopy() *SingleInstanceDatabaseAdminPassword {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseBackupStatus.
+func (in *SingleInstanceDatabaseBackupStatus) DeepCopy() *SingleInstanceDatabaseBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SingleInstanceDatabaseAdminPassword)
+	out := new(SingleInstanceDatabaseBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseCertIssuerRef) DeepCopyInto(out *SingleInstanceDatabaseCertIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseCertIssuerRef.
+func (in *SingleInstanceDatabaseCertIssuerRef) DeepCopy() *SingleInstanceDatabaseCertIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseCertIssuerRef)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4840,6 +5895,81 @@ func (in *SingleInstanceDatabaseList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseParameter) DeepCopyInto(out *SingleInstanceDatabaseParameter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseParameter.
+func (in *SingleInstanceDatabaseParameter) DeepCopy() *SingleInstanceDatabaseParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseParameterStatus) DeepCopyInto(out *SingleInstanceDatabaseParameterStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseParameterStatus.
+func (in *SingleInstanceDatabaseParameterStatus) DeepCopy() *SingleInstanceDatabaseParameterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseParameterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePatching) DeepCopyInto(out *SingleInstanceDatabasePatching) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePatching.
+func (in *SingleInstanceDatabasePatching) DeepCopy() *SingleInstanceDatabasePatching {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePdb) DeepCopyInto(out *SingleInstanceDatabasePdb) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePdb.
+func (in *SingleInstanceDatabasePdb) DeepCopy() *SingleInstanceDatabasePdb {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePdb)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabasePdbStatus) DeepCopyInto(out *SingleInstanceDatabasePdbStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabasePdbStatus.
+func (in *SingleInstanceDatabasePdbStatus) DeepCopy() *SingleInstanceDatabasePdbStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabasePdbStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleInstanceDatabasePersistence) DeepCopyInto(out *SingleInstanceDatabasePersistence) {
 	*out = *in
@@ -4900,6 +6030,63 @@ func (in *SingleInstanceDatabaseResources) DeepCopy() *SingleInstanceDatabaseRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseRestoreSource) DeepCopyInto(out *SingleInstanceDatabaseRestoreSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseRestoreSource.
+func (in *SingleInstanceDatabaseRestoreSource) DeepCopy() *SingleInstanceDatabaseRestoreSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseRestoreSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseRollingPatchStatus) DeepCopyInto(out *SingleInstanceDatabaseRollingPatchStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseRollingPatchStatus.
+func (in *SingleInstanceDatabaseRollingPatchStatus) DeepCopy() *SingleInstanceDatabaseRollingPatchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseRollingPatchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleInstanceDatabaseSnapshotStandbyStatus) DeepCopyInto(out *SingleInstanceDatabaseSnapshotStandbyStatus) {
+	*out = *in
+	if in.ConvertedAt != nil {
+		in, out := &in.ConvertedAt, &out.ConvertedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.RevertAt != nil {
+		in, out := &in.RevertAt, &out.RevertAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRevertedAt != nil {
+		in, out := &in.LastRevertedAt, &out.LastRevertedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseSnapshotStandbyStatus.
+func (in *SingleInstanceDatabaseSnapshotStandbyStatus) DeepCopy() *SingleInstanceDatabaseSnapshotStandbyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleInstanceDatabaseSnapshotStandbyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSpec) {
 	*out = *in
@@ -4925,11 +6112,31 @@ func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TcpsCertIssuerRef != nil {
+		in, out := &in.TcpsCertIssuerRef, &out.TcpsCertIssuerRef
+		*out = new(SingleInstanceDatabaseCertIssuerRef)
+		**out = **in
+	}
 	if in.TrueCacheServices != nil {
 		in, out := &in.TrueCacheServices, &out.TrueCacheServices
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RestoreFrom != nil {
+		in, out := &in.RestoreFrom, &out.RestoreFrom
+		*out = new(SingleInstanceDatabaseRestoreSource)
+		**out = **in
+	}
+	if in.Patching != nil {
+		in, out := &in.Patching, &out.Patching
+		*out = new(SingleInstanceDatabasePatching)
+		**out = **in
+	}
+	if in.Pdbs != nil {
+		in, out := &in.Pdbs, &out.Pdbs
+		*out = make([]SingleInstanceDatabasePdb, len(*in))
+		copy(*out, *in)
+	}
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -4946,6 +6153,30 @@ func (in *SingleInstanceDatabaseSpec) DeepCopyInto(out *SingleInstanceDatabaseSp
 		**out = **in
 	}
 	in.Resources.DeepCopyInto(&out.Resources)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]SingleInstanceDatabaseParameter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(corev1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseSpec.
@@ -4987,6 +6218,31 @@ func (in *SingleInstanceDatabaseStatus) DeepCopyInto(out *SingleInstanceDatabase
 	}
 	out.InitParams = in.InitParams
 	in.Persistence.DeepCopyInto(&out.Persistence)
+	if in.SnapshotStandby != nil {
+		in, out := &in.SnapshotStandby, &out.SnapshotStandby
+		*out = new(SingleInstanceDatabaseSnapshotStandbyStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RollingPatch != nil {
+		in, out := &in.RollingPatch, &out.RollingPatch
+		*out = new(SingleInstanceDatabaseRollingPatchStatus)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]SingleInstanceDatabaseParameterStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Pdbs != nil {
+		in, out := &in.Pdbs, &out.Pdbs
+		*out = make([]SingleInstanceDatabasePdbStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadReplicas != nil {
+		in, out := &in.ReadReplicas, &out.ReadReplicas
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleInstanceDatabaseStatus.
@@ -5048,6 +6304,21 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecret) DeepCopyInto(out *VaultSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecret.
+func (in *VaultSecret) DeepCopy() *VaultSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VmNetworkDetails) DeepCopyInto(out *VmNetworkDetails) {
 	*out = *in
@@ -218,6 +218,15 @@ const EnableFSFOCMD string = "ENABLE FAST_START FAILOVER;"
 const DisableFSFOCMD string = "STOP OBSERVER %s" +
 	"\nDISABLE FAST_START FAILOVER;"
 
+const StopObserverCMD string = "STOP OBSERVER %s;"
+
+const ShowObserverCMD string = "SHOW OBSERVER;"
+
+const SetMasterObserverCMD string = "SET MASTEROBSERVER TO %s;"
+
+// Label holding the broker name of an observer, observer pods created before the label existed run as the dataguardbroker name
+const ObserverNameLabel string = "database.oracle.com/observer-name"
+
 const RemoveDataguardConfiguration string = "DISABLE FAST_START FAILOVER;" +
 	"\nEDIT CONFIGURATION SET PROTECTION MODE AS MAXPERFORMANCE;" +
 	"\nREMOVE CONFIGURATION;"
@@ -390,6 +399,41 @@ const ReconcileBlocked string = "ReconcileBlocked"
 
 const ReconcileBlockedReason string = "LastReconcileCycleBlocked"
 
+// Standard conditions of a SingleInstanceDatabase
+const ConditionReady string = "Ready"
+
+const ConditionProvisioning string = "Provisioning"
+
+const ConditionPatching string = "Patching"
+
+const ConditionDegraded string = "Degraded"
+
+const ConditionConfigSyncing string = "ConfigSyncing"
+
+const DatabaseHealthyReason string = "DatabaseHealthy"
+
+const DatabaseNotReadyReason string = "DatabaseNotReady"
+
+const DatabasePendingReason string = "DatabasePending"
+
+const DatafilesCreatingReason string = "DatafilesCreating"
+
+const DatafilesCreatedReason string = "DatafilesCreated"
+
+const DatapatchPendingReason string = "DatapatchPending"
+
+const DatafilesPatchedReason string = "DatafilesPatched"
+
+const DatabaseErrorReason string = "DatabaseError"
+
+const ReconcileBlockedDegradedReason string = "ReconcileBlocked"
+
+const AsExpectedReason string = "AsExpected"
+
+const ConfigUpdatingReason string = "ConfigUpdating"
+
+const ConfigInSyncReason string = "ConfigInSync"
+
 const StatusPending string = "Pending"
 
 const StatusCreating string = "Creating"
@@ -404,6 +448,8 @@ const StatusReady string = "Healthy"
 
 const StatusError string = "Error"
 
+const StatusMaintenance string = "Maintenance"
+
 const StatusUnknown string = "Unknown"
 
 const ValueUnavailable string = "Unavailable"
@@ -532,6 +578,12 @@ const TlsCertsLocation string = "/run/secrets/tls_secret"
 // Check Mount in pods
 const PodMountsCmd string = "awk '$2 == \"%s\" {print}' /proc/mounts"
 
+// Checksum of the TCPS certificate mounted from the tls secret
+const TlsCertChecksumCMD string = "sha256sum " + TlsCertsLocation + "/cert.crt | cut -d' ' -f1"
+
+// Label set by cert-manager on the TCPS certificate secret, its value is the database name
+const TcpsCertSecretLabel string = "database.oracle.com/tcps-certificate-for"
+
 // TCPS clientWallet update command
 const ClientWalletUpdate string = "sed -i -e 's/HOST.*$/HOST=%s)/g' -e 's/PORT.*$/PORT=%d)/g' ${ORACLE_BASE}/oradata/clientWallet/${ORACLE_SID}/tnsnames.ora"
 
@@ -556,3 +608,324 @@ const TcpsPort string = "\"name\": \"listener-tcps\", \"protocol\": \"TCP\", \"p
 
 // Payload section for TCPS node port
 const TcpsNodePort string = "\"name\": \"listener-tcps\", \"protocol\": \"TCP\", \"port\": 2484, \"nodePort\": %d"
+
+// RMAN backup location inside the database pod ( persistence.backupVolumeClaimName )
+const BackupLocation string = "/opt/oracle/backup"
+
+// RMAN command files, logs and pid files of operator initiated backups
+const RmanLogLocation string = "/opt/oracle/oradata/rman"
+
+// Object storage ( OSB cloud module ) library, wallet and config location
+const ObjectStoreLibLocation string = "/opt/oracle/oradata/osbws"
+
+// RMAN backup statuses
+const StatusBackupScheduled string = "Scheduled"
+
+const StatusBackupRunning string = "Running"
+
+const StatusBackupCompleted string = "Completed"
+
+const StatusBackupFailed string = "Failed"
+
+// Writes the rman command file %[3]s as %[1]s/%[2]s.rman and runs it in the background
+const RmanRunInBackgroundCMD string = "mkdir -p %[1]s && echo -e \"%[3]s\" > %[1]s/%[2]s.rman && " +
+	"( nohup rman target / cmdfile=%[1]s/%[2]s.rman log=%[1]s/%[2]s.log > /dev/null 2>&1 & echo $! > %[1]s/%[2]s.pid )"
+
+const RmanJobStatusCMD string = "if [ -f %[1]s/%[2]s.pid ] && kill -0 $(cat %[1]s/%[2]s.pid) 2>/dev/null; then echo rman_status:RUNNING; " +
+	"elif grep -q 'Recovery Manager complete' %[1]s/%[2]s.log && ! grep -q 'RMAN-00569' %[1]s/%[2]s.log; then echo rman_status:COMPLETED; " +
+	"else echo rman_status:FAILED; grep -E 'RMAN-|ORA-' %[1]s/%[2]s.log | tail -5; fi"
+
+const RmanRetentionWindowCMD string = "CONFIGURE RETENTION POLICY TO RECOVERY WINDOW OF %d DAYS;"
+
+const RmanRetentionRedundancyCMD string = "CONFIGURE RETENTION POLICY TO REDUNDANCY %d;"
+
+const RmanDiskChannelCMD string = "ALLOCATE CHANNEL c1 DEVICE TYPE DISK FORMAT '%s/%%d_%%T_%%U';"
+
+const RmanSbtChannelCMD string = "ALLOCATE CHANNEL c1 DEVICE TYPE SBT PARMS 'SBT_LIBRARY=%[1]s/libosbws.so,SBT_PARMS=(OSB_WS_PFILE=%[1]s/osbws_%[2]s.ora)';"
+
+const RmanBackupDatabaseCMD string = "BACKUP %[1]s TAG '%[2]s' %[3]s DATABASE PLUS ARCHIVELOG;"
+
+const RmanBackupArchivelogCMD string = "BACKUP %[1]s TAG '%[2]s' ARCHIVELOG ALL NOT BACKED UP 1 TIMES;"
+
+// Control file and spfile pieces carry a "_ctl_" marker so that restores can find them without a catalog
+const RmanBackupControlfileCMD string = "BACKUP TAG '%[1]s' CURRENT CONTROLFILE FORMAT '%[2]s%%d_%%T_ctl_%%U' SPFILE FORMAT '%[2]s%%d_%%T_spf_%%U';"
+
+const RmanDeleteObsoleteCMD string = "CROSSCHECK BACKUP;\nDELETE NOPROMPT OBSOLETE;"
+
+// Installs the OSB cloud module for an S3 compatible endpoint. %[2]s identifies the destination
+const ObjectStoreInstallCMD string = "if [ ! -f %[1]s/osbws_%[2]s.ora ]; then mkdir -p %[1]s/wallet_%[2]s && " +
+	"$ORACLE_HOME/jdk/bin/java -jar $ORACLE_HOME/lib/osbws_install.jar -AWSID '%[3]s' -AWSKey '%[4]s' -awsEndpoint %[5]s " +
+	"-location %[6]s -bucket %[7]s -useSigV4 -useHttps -walletDir %[1]s/wallet_%[2]s -libDir %[1]s -configFile %[1]s/osbws_%[2]s.ora; fi"
+
+const RmanBackupPiecesSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT 'piece:' || handle FROM V\\$BACKUP_PIECE WHERE tag = '%s' AND status = 'A' ORDER BY completion_time;"
+
+const RmanBackupScnSQL string = "SELECT 'scn:' || MAX(scn) FROM (SELECT checkpoint_change# scn, set_stamp, set_count FROM V\\$BACKUP_DATAFILE" +
+	" UNION ALL SELECT next_change# - 1, set_stamp, set_count FROM V\\$BACKUP_REDOLOG) b, V\\$BACKUP_PIECE p" +
+	" WHERE b.set_stamp = p.set_stamp AND b.set_count = p.set_count AND p.tag = '%s';"
+
+const RmanActiveBackupTagsSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT DISTINCT 'tag:' || tag FROM V\\$BACKUP_PIECE WHERE status = 'A' AND tag IS NOT NULL;"
+
+const GetDbIdSQL string = "SELECT 'dbid:' || dbid FROM V\\$DATABASE;"
+
+// Restore of a database from a SingleInstanceDatabaseBackup
+// The database container waits for the restore marker before starting the database
+const RestoreMarkerFile string = "${ORACLE_BASE}/oradata/.${ORACLE_SID}.restored"
+
+const WaitForRestoreCMD string = "while [ ! -f " + RestoreMarkerFile + " ]; do sleep 5; done; exec $ORACLE_BASE/$RUN_FILE"
+
+// Restore condition and its phases
+const DatabaseRestored string = "DatabaseRestored"
+
+const RestorePendingReason string = "RestorePending"
+
+const RestoringControlfileReason string = "RestoringControlfile"
+
+const RestoringDatabaseReason string = "RestoringDatabase"
+
+const RestoreCompleteReason string = "RestoreComplete"
+
+const RestoreFailedReason string = "RestoreFailed"
+
+const RmanRestoreDiskChannelCMD string = "ALLOCATE CHANNEL c1 DEVICE TYPE DISK;"
+
+const RestoreSpfileCMD string = "( echo -e \"SHUTDOWN ABORT;\" | " + SQLPlusCLI + " ; " +
+	"mkdir -p ${ORACLE_BASE}/admin/${ORACLE_SID}/adump && echo db_name=${ORACLE_SID} > /tmp/init_restore.ora && " +
+	"echo -e \"STARTUP NOMOUNT PFILE='/tmp/init_restore.ora';\nRUN {\n%[1]s\nRESTORE SPFILE TO '${ORACLE_HOME}/dbs/spfile${ORACLE_SID}.ora' FROM '%[2]s';\n}\n" +
+	"SHUTDOWN IMMEDIATE;\nSTARTUP NOMOUNT;\" | rman target / ) 2>&1"
+
+const RestoreControlfileCMD string = "echo -e \"RUN {\n%[1]s\nRESTORE CONTROLFILE FROM '%[2]s';\n}\nALTER DATABASE MOUNT;\" | rman target / 2>&1"
+
+const RestoreInstanceDirectoriesSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT 'dir:' || CASE WHEN name = 'control_files' THEN SUBSTR(value, 1, INSTR(value, '/', -1) - 1) ELSE value END FROM V\\$SPPARAMETER" +
+	" WHERE name IN ('control_files', 'db_recovery_file_dest', 'audit_file_dest') AND value IS NOT NULL;"
+
+const RestoreDatafileDirectoriesSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT DISTINCT 'dir:' || SUBSTR(name, 1, INSTR(name, '/', -1) - 1) FROM" +
+	" (SELECT name FROM V\\$DATAFILE UNION ALL SELECT name FROM V\\$TEMPFILE UNION ALL SELECT member FROM V\\$LOGFILE);"
+
+const RmanCatalogLocationCMD string = "CATALOG START WITH '%s/' NOPROMPT;"
+
+const RmanConfigureSbtChannelCMD string = "CONFIGURE CHANNEL DEVICE TYPE SBT PARMS 'SBT_LIBRARY=%[1]s/libosbws.so,SBT_PARMS=(OSB_WS_PFILE=%[1]s/osbws_%[2]s.ora)';"
+
+const RmanCatalogSbtPiecesCMD string = "CATALOG DEVICE TYPE SBT BACKUPPIECE %s;"
+
+const RmanSetUntilScnCMD string = "SET UNTIL SCN %s;"
+
+const RmanSetUntilTimeCMD string = "SET UNTIL TIME \\\"TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS')\\\";"
+
+const RmanRestoreRecoverCMD string = "RESTORE DATABASE;\nRECOVER DATABASE;"
+
+const OpenResetlogsSQL string = "ALTER DATABASE OPEN RESETLOGS;"
+
+// Recreates the files the database container expects under dbconfig, then releases the database start
+const RestoreFinalizeCMD string = "( mkdir -p ${ORACLE_BASE}/oradata/dbconfig/${ORACLE_SID} && cd ${ORACLE_BASE}/oradata/dbconfig/${ORACLE_SID} && " +
+	"echo -e \"SHUTDOWN IMMEDIATE;\" | " + SQLPlusCLI + " && " +
+	"mv -f ${ORACLE_HOME}/dbs/spfile${ORACLE_SID}.ora . && " +
+	"orapwd file=orapw${ORACLE_SID} password='%[1]s' force=y format=12 && " +
+	"echo \"${ORACLE_SID}:${ORACLE_HOME}:N\" > oratab && " +
+	"echo \"NAMES.DIRECTORY_PATH= (TNSNAMES, EZCONNECT, HOSTNAME)\" > sqlnet.ora && " +
+	"echo -e \"LISTENER =\n(DESCRIPTION_LIST =\n  (DESCRIPTION =\n    (ADDRESS = (PROTOCOL = IPC)(KEY = EXTPROC1))\n    (ADDRESS = (PROTOCOL = TCP)(HOST = 0.0.0.0)(PORT = 1521))\n  )\n)\n\n" +
+	"DEDICATED_THROUGH_BROKER_LISTENER=ON\nDIAG_ADR_ENABLED = off\" > listener.ora && " +
+	"echo -e \"${ORACLE_SID}=\n(DESCRIPTION =\n  (ADDRESS = (PROTOCOL = TCP)(HOST = 0.0.0.0)(PORT = 1521))\n  (CONNECT_DATA =\n    (SERVER = DEDICATED)\n    (SERVICE_NAME = ${ORACLE_SID})\n  )\n)\" > tnsnames.ora && " +
+	"touch .docker_%[2]s ${ORACLE_BASE}/oradata/.${ORACLE_SID}${CHECKPOINT_FILE_EXTN} && touch " + RestoreMarkerFile + " ) 2>&1"
+
+// Standby-first rolling patch condition and phases
+const RollingPatchCondition string = "RollingPatch"
+
+const RollingPatchStandbysPhase string = "PatchingStandbys"
+
+const RollingPatchSwitchoverPhase string = "SwitchingOver"
+
+const RollingPatchPrimaryPhase string = "PatchingPrimary"
+
+const RollingPatchDatapatchPhase string = "RunningDatapatch"
+
+const RollingPatchSwitchbackPhase string = "SwitchingBack"
+
+const RollingPatchCompleteReason string = "RollingPatchComplete"
+
+// Deletion policy dropping the database objects, such as pluggable databases and users, removed from the resources
+const DeletionPolicyDelete string = "Delete"
+
+// Declarative pluggable database management
+const GetPdbOpenModesSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT 'pdb:' || name || ':' || REPLACE(open_mode, ' ', '_') FROM V\\$PDBS WHERE name <> 'PDB\\$SEED';"
+
+const CreatePdbFromSeedSQL string = "CREATE PLUGGABLE DATABASE %[1]s ADMIN USER %[2]s IDENTIFIED BY \\\"%[3]s\\\" CREATE_FILE_DEST='${ORACLE_BASE}/oradata';"
+
+const ClonePdbSQL string = "CREATE PLUGGABLE DATABASE %[1]s FROM %[2]s CREATE_FILE_DEST='${ORACLE_BASE}/oradata';"
+
+const OpenPdbSQL string = "ALTER PLUGGABLE DATABASE %[1]s OPEN;" +
+	"\nALTER PLUGGABLE DATABASE %[1]s SAVE STATE;"
+
+const DropPdbSQL string = "ALTER PLUGGABLE DATABASE %[1]s CLOSE IMMEDIATE;" +
+	"\nDROP PLUGGABLE DATABASE %[1]s INCLUDING DATAFILES;"
+
+// Declarative database users
+// The script is passed through a quoted here-document so that passwords are not expanded by the shell
+const SQLPlusScriptCMD string = SQLPlusCLI + " <<'SQLEOF'\n%s\nSQLEOF"
+
+const SetContainerSQL string = "ALTER SESSION SET CONTAINER=%s;"
+
+const GetDatabaseUserSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT 'user:' || account_status || ':' || default_tablespace || ':' || temporary_tablespace FROM DBA_USERS WHERE username = '%[1]s';" +
+	"\nSELECT 'quota:' || tablespace_name || ':' || DECODE(max_bytes, -1, 'UNLIMITED', max_bytes) FROM DBA_TS_QUOTAS WHERE username = '%[1]s';" +
+	"\nSELECT 'role:' || granted_role FROM DBA_ROLE_PRIVS WHERE grantee = '%[1]s';" +
+	"\nSELECT 'syspriv:' || privilege FROM DBA_SYS_PRIVS WHERE grantee = '%[1]s';" +
+	"\nSELECT 'objpriv:' || privilege || ' ON ' || owner || '.' || table_name FROM DBA_TAB_PRIVS WHERE grantee = '%[1]s';"
+
+const DropDatabaseUserSQL string = "DROP USER %s CASCADE;"
+
+const UserSyncedReason string = "UserSynced"
+
+const UserSyncFailedReason string = "UserSyncFailed"
+
+// Active Data Guard read replicas of a primary database
+const ReadReplicaOfLabel string = "database.oracle.com/read-replica-of"
+
+// Snapshot standby conversion requested by a DataguardOperation, takes precedence over .spec.convertToSnapshotStandby until the spec matches it
+const ConvertToSnapshotStandbyAnnotation string = "database.oracle.com/convert-to-snapshot-standby"
+
+// Free-form initialization parameters
+const GetParametersSQL string = "set linesize 4000 pagesize 0;" +
+	"\nSELECT 'startup:' || TO_CHAR(startup_time, 'YYYY-MM-DD HH24:MI:SS') FROM V$INSTANCE;" +
+	"\nSELECT 'param:' || name || '|' || issys_modifiable || '|' || value FROM V$PARAMETER WHERE name IN (%s);"
+
+const AlterSystemSetSQL string = "ALTER SYSTEM SET %s=%s%s SCOPE=%s;"
+
+const AlterSystemResetSQL string = "ALTER SYSTEM RESET %s SCOPE=SPFILE;"
+
+const RestartDatabaseCMD string = CreateChkFileCMD + " && " +
+	"echo -e  \"SHUTDOWN IMMEDIATE; \n STARTUP MOUNT; \n ALTER DATABASE OPEN; \n ALTER PLUGGABLE DATABASE ALL OPEN; \n ALTER SYSTEM REGISTER;\" | %s && " +
+	RemoveChkFileCMD
+
+// Stops the application services of the current pluggable database, the default PDB service cannot be stopped
+const StopApplicationServicesSQL string = "BEGIN" +
+	"\n  FOR s IN (SELECT name FROM V\\$ACTIVE_SERVICES WHERE name NOT LIKE 'SYS\\$%' AND con_id = SYS_CONTEXT('USERENV', 'CON_ID')) LOOP" +
+	"\n    BEGIN DBMS_SERVICE.STOP_SERVICE(s.name); EXCEPTION WHEN OTHERS THEN NULL; END;" +
+	"\n  END LOOP;" +
+	"\nEND;\n/\n"
+
+// Waits up to 120 seconds for the application sessions to disconnect from the pluggable databases
+const DrainApplicationSessionsSQL string = "DECLARE n NUMBER;" +
+	"\nBEGIN" +
+	"\n  FOR i IN 1..24 LOOP" +
+	"\n    SELECT COUNT(*) INTO n FROM V\\$SESSION WHERE type = 'USER' AND con_id > 2;" +
+	"\n    EXIT WHEN n = 0;" +
+	"\n    DBMS_LOCK.SLEEP(5);" +
+	"\n  END LOOP;" +
+	"\nEND;\n/\n"
+
+// Graceful shutdown of the instance: the application services of the open pluggable databases are stopped and
+// their sessions drained, then the pluggable databases are closed, disconnecting the remaining sessions
+const GracefulShutdownCMD string = "export ORACLE_SID=${ORACLE_SID^^}; " +
+	"for pdb in $(/bin/echo -en \"set heading off feedback off pagesize 0\nSELECT name FROM V\\$PDBS WHERE open_mode LIKE 'READ%';\n\" | sqlplus -S / as sysdba); do " +
+	"/bin/echo -en \"ALTER SESSION SET CONTAINER=${pdb};\n" + StopApplicationServicesSQL + "\" | sqlplus -S / as sysdba; done; " +
+	"/bin/echo -en \"" + DrainApplicationSessionsSQL + "ALTER PLUGGABLE DATABASE ALL CLOSE IMMEDIATE;\nSHUTDOWN IMMEDIATE;\n\" | sqlplus -S / as sysdba"
+
+// Time given to the preStop hook to shut the instance down before the pod is killed
+const GracefulShutdownPeriodSeconds int64 = 300
+
+// Failover and reinstatement of the former primary database in a dataguard configuration
+const ConditionFailedOver string = "FailedOver"
+
+const ConditionReinstateRequired string = "ReinstateRequired"
+
+const FailoverReason string = "Failover"
+
+const SwitchoverReason string = "Switchover"
+
+const ReinstatePendingReason string = "ReinstatePending"
+
+const FlashbackDisabledReason string = "FlashbackDisabled"
+
+const ReinstateFailedReason string = "ReinstateFailed"
+
+const ReinstatedReason string = "Reinstated"
+
+const ShowDatabaseCMD string = "SHOW DATABASE %s;"
+
+const ReinstateDatabaseCMD string = "REINSTATE DATABASE %s;"
+
+const MountFormerPrimaryCMD string = CreateChkFileCMD + " && echo -e  \"SHUTDOWN ABORT; \n STARTUP MOUNT;\" | %s"
+
+// Protection mode and redo transport properties of a dataguard configuration
+const SetProtectionModeCMD string = "EDIT CONFIGURATION SET PROTECTION MODE AS %s;"
+
+const EditDatabasePropertyCMD string = "EDIT DATABASE %s SET PROPERTY %s=%s;"
+
+const ResetDatabasePropertyCMD string = "EDIT DATABASE %s RESET PROPERTY %s;"
+
+// Far sync instance of a dataguard configuration
+// The far sync pod writes a listener with static services, so that the instance is reachable before it is mounted
+const FarSyncStartCMD string = "mkdir -p ${TNS_ADMIN} ${ORACLE_BASE}/oradata/${ORACLE_SID} && " +
+	"echo -e \"LISTENER=(DESCRIPTION_LIST=(DESCRIPTION=(ADDRESS=(PROTOCOL=TCP)(HOST=0.0.0.0)(PORT=1521))))\n" +
+	"SID_LIST_LISTENER=(SID_LIST=(SID_DESC=(GLOBAL_DBNAME=${ORACLE_SID})(ORACLE_HOME=${ORACLE_HOME})(SID_NAME=${ORACLE_SID}))" +
+	"(SID_DESC=(GLOBAL_DBNAME=${ORACLE_SID}_DGMGRL)(ORACLE_HOME=${ORACLE_HOME})(SID_NAME=${ORACLE_SID})))\" > ${TNS_ADMIN}/listener.ora && " +
+	"lsnrctl start && " +
+	"if [ -f ${ORACLE_BASE}/oradata/${ORACLE_SID}/control01.ctl ]; then " +
+	"echo -e \"STARTUP MOUNT PFILE='${ORACLE_BASE}/oradata/${ORACLE_SID}/init.ora';\" | " + SQLPlusCLI + "; fi; " +
+	"exec tail -f /dev/null"
+
+const GetFarSyncParametersSQL string = "set linesize 1000 pagesize 0;" +
+	"\nSELECT 'param:' || name || ':' || value FROM V\\$PARAMETER WHERE name IN ('db_name', 'compatible');"
+
+// Creates the far sync controlfile from the primary database, the standby redo logs of the primary are duplicated with it
+const FarSyncCreateCMD string = "( cd ${ORACLE_BASE}/oradata/${ORACLE_SID} && " +
+	"mkdir -p fra ${ORACLE_BASE}/admin/${ORACLE_SID}/adump && " +
+	"orapwd file=${ORACLE_HOME}/dbs/orapw${ORACLE_SID} password='%[1]s' force=y format=12 && " +
+	"echo -e \"db_name=%[2]s\ndb_unique_name=${ORACLE_SID}\ncompatible=%[3]s\n" +
+	"control_files='${ORACLE_BASE}/oradata/${ORACLE_SID}/control01.ctl'\n" +
+	"db_create_file_dest='${ORACLE_BASE}/oradata/${ORACLE_SID}'\n" +
+	"db_recovery_file_dest='${ORACLE_BASE}/oradata/${ORACLE_SID}/fra'\ndb_recovery_file_dest_size=20G\n" +
+	"audit_file_dest='${ORACLE_BASE}/admin/${ORACLE_SID}/adump'\nstandby_file_management=AUTO\ndg_broker_start=TRUE\" > init.ora && " +
+	"echo -e \"SHUTDOWN ABORT;\nSTARTUP NOMOUNT PFILE='${ORACLE_BASE}/oradata/${ORACLE_SID}/init.ora';\" | " + SQLPlusCLI + " && " +
+	"echo -e \"DUPLICATE TARGET DATABASE FOR FARSYNC FROM ACTIVE DATABASE NOFILENAMECHECK;\" | " +
+	"rman target sys/'%[1]s'@%[4]s auxiliary sys/'%[1]s'@localhost:1521/${ORACLE_SID} ) 2>&1"
+
+const AddFarSyncCMD string = "ADD FAR_SYNC %[1]s AS CONNECT IDENTIFIER IS '%[2]s:1521/%[1]s';" +
+	"\nEDIT FAR_SYNC %[1]s SET PROPERTY StaticConnectIdentifier='(DESCRIPTION=(ADDRESS=(PROTOCOL=tcp)(HOST=%[2]s)(PORT=1521))" +
+	"(CONNECT_DATA=(SERVICE_NAME=%[1]s_DGMGRL)(INSTANCE_NAME=%[1]s)(SERVER=DEDICATED)))';"
+
+const EnableFarSyncCMD string = "ENABLE FAR_SYNC %s;"
+
+const SetRedoRoutesCMD string = "EDIT DATABASE %[1]s SET PROPERTY RedoRoutes='(LOCAL : %[2]s SYNC%[3]s)';" +
+	"\nEDIT FAR_SYNC %[2]s SET PROPERTY RedoRoutes='(%[1]s : %[4]s)';"
+
+const RemoveFarSyncCMD string = "EDIT DATABASE %[1]s SET PROPERTY RedoRoutes='';" +
+	"\nDISABLE FAR_SYNC %[2]s;" +
+	"\nREMOVE FAR_SYNC %[2]s;"
+
+// External database of a dataguard configuration, prepared as a physical standby outside of the cluster
+const AddExternalDatabaseCMD string = "ADD DATABASE %[1]s AS CONNECT IDENTIFIER IS '%[2]s';" +
+	"\\nEDIT DATABASE %[1]s SET PROPERTY LogXptMode='%[3]s';" +
+	"\\nENABLE DATABASE %[1]s;"
+
+const SwitchoverCMD string = "SWITCHOVER TO %s;"
+
+// Dataguard operations
+const StatusOperationRunning string = "Running"
+
+const StatusOperationSucceeded string = "Succeeded"
+
+const StatusOperationFailed string = "Failed"
+
+const ValidateDatabaseCMD string = "VALIDATE DATABASE %s;"
+
+const FailoverCMD string = "FAILOVER TO %s%s;"
+
+const ConvertDatabaseCMD string = "CONVERT DATABASE %s TO %s STANDBY;"
+
+// Snapshot standby restore point and redo received while converted
+const CreateSnapshotRestorePointSQL string = "CREATE RESTORE POINT %[1]s GUARANTEE FLASHBACK DATABASE;" +
+	"\nSELECT 'restore_point_scn:' || SCN FROM V\\$RESTORE_POINT WHERE NAME = UPPER('%[1]s');"
+
+const DropSnapshotRestorePointSQL string = "DROP RESTORE POINT %s;"
+
+// No row is returned without the restore point of the snapshot standby conversion
+const SnapshotStandbyRedoSQL string = "SELECT 'snapshot_redo:' || COUNT(a.SEQUENCE#) || ':' || NVL(SUM(a.BLOCKS*a.BLOCK_SIZE),0)" +
+	" FROM (SELECT MIN(SCN) SCN FROM V\\$RESTORE_POINT WHERE NAME LIKE 'SNAPSHOT_STANDBY_REQUIRED%') rp" +
+	" LEFT JOIN V\\$ARCHIVED_LOG a ON a.REGISTRAR = 'RFS' AND a.NEXT_CHANGE# > rp.SCN" +
+	" WHERE rp.SCN IS NOT NULL GROUP BY rp.SCN;"
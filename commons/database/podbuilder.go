@@ -49,9 +49,11 @@ type PodBuilder interface {
 	SetLabels(map[string]string) *PodBuilder
 	SetTerminationGracePeriodSeconds(int64) *PodBuilder
 	SetNodeSelector(map[string]string) *PodBuilder
+	SetAffinity(*corev1.Affinity) *PodBuilder
 	SetSecurityContext(corev1.PodSecurityContext) *PodBuilder
 	SetImagePullSecrets(string) *PodBuilder
 	AppendContainers(corev1.Container) *PodBuilder
+	AppendVolumes(corev1.Volume) *PodBuilder
 	Build() corev1.Pod
 }
 
@@ -80,6 +82,11 @@ func (rpb *RealPodBuilder) SetNodeSelector(nsRule map[string]string) *RealPodBui
 	return rpb
 }
 
+func (rpb *RealPodBuilder) SetAffinity(affinity *corev1.Affinity) *RealPodBuilder {
+	rpb.pod.Spec.Affinity = affinity
+	return rpb
+}
+
 func (rpb *RealPodBuilder) SetSecurityContext(podSecurityContext corev1.PodSecurityContext) *RealPodBuilder {
 	rpb.pod.Spec.SecurityContext = &podSecurityContext
 	return rpb
@@ -99,6 +106,11 @@ func (rpb *RealPodBuilder) AppendContainers(container corev1.Container) *RealPod
 	return rpb
 }
 
+func (rpb *RealPodBuilder) AppendVolumes(volume corev1.Volume) *RealPodBuilder {
+	rpb.pod.Spec.Volumes = append(rpb.pod.Spec.Volumes, volume)
+	return rpb
+}
+
 func (rpb *RealPodBuilder) Build() corev1.Pod {
 	return rpb.pod
 }
@@ -0,0 +1,177 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package commons
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr bool
+	}{
+		{name: "fields", spec: "30 2 * * 0"},
+		{name: "descriptor", spec: "@daily"},
+		{name: "descriptor case", spec: "@Weekly"},
+		{name: "lists ranges and steps", spec: "0,30 8-18/2 1-15 */3 1-5"},
+		{name: "sunday as 7", spec: "0 0 * * 7"},
+		{name: "empty", spec: "", wantErr: true},
+		{name: "too few fields", spec: "0 0 * *", wantErr: true},
+		{name: "too many fields", spec: "0 0 * * * *", wantErr: true},
+		{name: "minute out of range", spec: "60 0 * * *", wantErr: true},
+		{name: "hour out of range", spec: "0 24 * * *", wantErr: true},
+		{name: "day of month zero", spec: "0 0 0 * *", wantErr: true},
+		{name: "reversed range", spec: "0 10-8 * * *", wantErr: true},
+		{name: "zero step", spec: "*/0 * * * *", wantErr: true},
+		{name: "not a number", spec: "a * * * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCronSchedule(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCronSchedule(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNext(t *testing.T) {
+	india := time.FixedZone("IST", 5*3600+30*60)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+	tests := []struct {
+		name string
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "next minute",
+			spec: "* * * * *",
+			from: time.Date(2024, 1, 1, 10, 0, 30, 0, time.UTC),
+			want: time.Date(2024, 1, 1, 10, 1, 0, 0, time.UTC),
+		},
+		{
+			name: "strictly after",
+			spec: "30 2 * * *",
+			from: time.Date(2024, 1, 1, 2, 30, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 2, 2, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "hourly",
+			spec: "@hourly",
+			from: time.Date(2024, 1, 1, 10, 45, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "half hour offset zone",
+			spec: "15 * * * *",
+			from: time.Date(2024, 1, 1, 10, 45, 0, 0, india),
+			want: time.Date(2024, 1, 1, 11, 15, 0, 0, india),
+		},
+		{
+			name: "half hour offset zone top of hour",
+			spec: "0 11 * * *",
+			from: time.Date(2024, 1, 1, 10, 45, 0, 0, india),
+			want: time.Date(2024, 1, 1, 11, 0, 0, 0, india),
+		},
+		{
+			name: "weekly on sunday",
+			spec: "0 3 * * 7",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 7, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			spec: "0 0 15 * 1",
+			from: time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "monthly across year",
+			spec: "@monthly",
+			from: time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "leap day",
+			spec: "0 0 29 2 *",
+			from: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "daylight saving gap",
+			spec: "30 3 * * *",
+			from: time.Date(2024, 3, 10, 1, 0, 0, 0, newYork),
+			want: time.Date(2024, 3, 10, 3, 30, 0, 0, newYork),
+		},
+		{
+			name: "daylight saving gap at midnight",
+			spec: "0 1 * * *",
+			from: time.Date(2024, 9, 7, 12, 0, 0, 0, santiago),
+			want: time.Date(2024, 9, 8, 1, 0, 0, 0, santiago),
+		},
+		{
+			name: "never matches",
+			spec: "0 0 31 2 *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseCronSchedule(tt.spec)
+			if err != nil {
+				t.Fatalf("ParseCronSchedule(%q) error = %v", tt.spec, err)
+			}
+			if got := s.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("Next(%v) = %v, want %v", tt.from, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package hashicorp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// Reads secrets from the KV version 2 secrets engine of a HashiCorp Vault server
+type VaultService interface {
+	GetSecretValue(path string, key string) (string, error)
+}
+
+type VaultConfig struct {
+	Address   string
+	Mount     string
+	Namespace string
+	// PEM encoded CA certificate of the server, the system CAs are trusted when empty
+	CACert []byte
+	// Vault token, or the role, mount and ServiceAccount token of the Kubernetes auth method when empty
+	Token               string
+	KubernetesAuthRole  string
+	KubernetesAuthMount string
+	KubernetesAuthJWT   string
+}
+
+type vaultService struct {
+	logger     logr.Logger
+	config     VaultConfig
+	httpClient *http.Client
+}
+
+func NewVaultService(
+	logger logr.Logger,
+	config VaultConfig) (VaultService, error) {
+
+	if config.Address == "" {
+		return nil, errors.New("HashiCorp Vault address is required")
+	}
+
+	v := &vaultService{
+		logger:     logger.WithName("hashicorpVaultService"),
+		config:     config,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+	if len(config.CACert) > 0 {
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(config.CACert) {
+			return nil, errors.New("no PEM encoded certificate found in the HashiCorp Vault CA certificate")
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+		v.httpClient.Transport = transport
+	}
+
+	if v.config.Token == "" {
+		token, err := v.kubernetesLogin()
+		if err != nil {
+			return nil, err
+		}
+		v.config.Token = token
+	}
+
+	return v, nil
+}
+
+// Logs in with the ServiceAccount token of the Kubernetes auth method
+func (v *vaultService) kubernetesLogin() (string, error) {
+	if v.config.KubernetesAuthRole == "" || v.config.KubernetesAuthJWT == "" {
+		return "", errors.New("either a HashiCorp Vault token or a Kubernetes auth role and ServiceAccount token is required")
+	}
+
+	body, err := json.Marshal(map[string]string{"role": v.config.KubernetesAuthRole, "jwt": v.config.KubernetesAuthJWT})
+	if err != nil {
+		return "", err
+	}
+
+	var response struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	if err := v.do(http.MethodPost, "auth/"+v.config.KubernetesAuthMount+"/login", body, &response); err != nil {
+		return "", err
+	}
+	if response.Auth.ClientToken == "" {
+		return "", errors.New("HashiCorp Vault Kubernetes login returned no token")
+	}
+	return response.Auth.ClientToken, nil
+}
+
+func (v *vaultService) GetSecretValue(path string, key string) (string, error) {
+	var response struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := v.do(http.MethodGet, v.config.Mount+"/data/"+strings.TrimPrefix(path, "/"), nil, &response); err != nil {
+		return "", err
+	}
+
+	value, ok := response.Data.Data[key].(string)
+	if !ok {
+		return "", fmt.Errorf("key %s not found in HashiCorp Vault secret %s", key, path)
+	}
+	return value, nil
+}
+
+func (v *vaultService) do(method string, path string, body []byte, out interface{}) error {
+	request, err := http.NewRequestWithContext(context.TODO(), method,
+		strings.TrimSuffix(v.config.Address, "/")+"/v1/"+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if v.config.Token != "" {
+		request.Header.Set("X-Vault-Token", v.config.Token)
+	}
+	if v.config.Namespace != "" {
+		request.Header.Set("X-Vault-Namespace", v.config.Namespace)
+	}
+
+	response, err := v.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("HashiCorp Vault request %s returned %s", path, response.Status)
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,490 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DatabaseUserReconciler reconciles a DatabaseUser object
+type DatabaseUserReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Config   *rest.Config
+	Recorder record.EventRecorder
+}
+
+const databaseUserFinalizer = "database.oracle.com/databaseuserfinalizer"
+
+// Interval at which the user is compared with the database to correct drift
+const databaseUserResyncInterval = 10 * time.Minute
+
+// Current definition of a user read from the data dictionary
+type databaseUserState struct {
+	exists              bool
+	accountStatus       string
+	defaultTablespace   string
+	temporaryTablespace string
+	quotas              map[string]string
+	roles               map[string]bool
+	systemPrivileges    map[string]bool
+	objectPrivileges    map[string]bool
+}
+
+//+kubebuilder:rbac:groups=database.oracle.com,resources=databaseusers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=database.oracle.com,resources=databaseusers/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=database.oracle.com,resources=databaseusers/finalizers,verbs=update
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods;pods/exec,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile creates the user in the referred SingleInstanceDatabase and keeps its password, tablespaces,
+// quotas, roles and grants in line with the spec. The database is checked periodically to correct drift.
+func (r *DatabaseUserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Reconcile", req.NamespacedName)
+
+	user := &dbapi.DatabaseUser{}
+	if err := r.Get(ctx, req.NamespacedName, user); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Resource not found")
+			return requeueN, nil
+		}
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	sidb := &dbapi.SingleInstanceDatabase{}
+	sidbErr := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: user.Spec.SingleInstanceDatabaseRef}, sidb)
+	if sidbErr != nil && !apierrors.IsNotFound(sidbErr) {
+		return requeueY, sidbErr
+	}
+
+	if user.GetDeletionTimestamp() != nil {
+		return r.manageDatabaseUserDeletion(user, sidb, apierrors.IsNotFound(sidbErr), ctx, req)
+	}
+	if !controllerutil.ContainsFinalizer(user, databaseUserFinalizer) {
+		controllerutil.AddFinalizer(user, databaseUserFinalizer)
+		if err := r.Update(ctx, user); err != nil {
+			return requeueY, err
+		}
+	}
+
+	if user.Status.Status == "" {
+		user.Status.Status = dbcommons.StatusPending
+	}
+	if apierrors.IsNotFound(sidbErr) {
+		r.Recorder.Eventf(user, corev1.EventTypeWarning, "DatabaseNotFound",
+			"SingleInstanceDatabase %s not found", user.Spec.SingleInstanceDatabaseRef)
+		r.setDatabaseUserCondition(user, false, dbcommons.DatabaseNotReadyReason,
+			"SingleInstanceDatabase "+user.Spec.SingleInstanceDatabaseRef+" not found")
+		return requeueY, r.Status().Update(ctx, user)
+	}
+	if sidb.Status.Status != dbcommons.StatusReady || strings.ToUpper(sidb.Status.Role) != "PRIMARY" {
+		log.Info("Database not ready, waiting to sync user", "database", sidb.Name)
+		r.setDatabaseUserCondition(user, false, dbcommons.DatabaseNotReadyReason,
+			"waiting for the primary database "+sidb.Name+" to be ready")
+		return requeueY, r.Status().Update(ctx, user)
+	}
+
+	result, err := r.syncDatabaseUser(user, sidb, ctx, req)
+	if statusErr := r.Status().Update(ctx, user); statusErr != nil {
+		return requeueY, statusErr
+	}
+	return result, err
+}
+
+// #############################################################################
+//
+//	Create or alter the user so that it matches the spec
+//
+// #############################################################################
+func (r *DatabaseUserReconciler) syncDatabaseUser(u *dbapi.DatabaseUser, sidb *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("syncDatabaseUser", req.NamespacedName)
+
+	readyPod, err := GetDatabaseReadyPod(r, sidb, ctx, req)
+	if err != nil {
+		return requeueY, err
+	}
+	if readyPod.Name == "" {
+		log.Info("No ready pod for database", "database", sidb.Name)
+		return requeueY, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: u.Namespace, Name: u.Spec.PasswordSecret.SecretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Recorder.Eventf(u, corev1.EventTypeWarning, "SecretNotFound", "Secret %s not found", u.Spec.PasswordSecret.SecretName)
+			r.setDatabaseUserCondition(u, false, dbcommons.UserSyncFailedReason, "secret "+u.Spec.PasswordSecret.SecretName+" not found")
+			return requeueY, nil
+		}
+		return requeueY, err
+	}
+	password := strings.TrimSpace(string(secret.Data[u.Spec.PasswordSecret.SecretKey]))
+	if password == "" || strings.ContainsAny(password, "\"\n") {
+		r.Recorder.Eventf(u, corev1.EventTypeWarning, "InvalidPassword",
+			"Key %s of secret %s must hold a password without double quotes", u.Spec.PasswordSecret.SecretKey, secret.Name)
+		r.setDatabaseUserCondition(u, false, dbcommons.UserSyncFailedReason, "invalid password in secret "+secret.Name)
+		u.Status.Status = dbcommons.StatusError
+		return requeueN, nil
+	}
+
+	state, err := r.getDatabaseUserState(u, readyPod, ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	username := strings.ToUpper(u.Spec.Username)
+	container := ""
+	if u.Spec.PdbName == "" {
+		container = " CONTAINER=ALL"
+	}
+	var stmts []string
+	rotated := false
+	if !state.exists {
+		stmt := fmt.Sprintf("CREATE USER %s IDENTIFIED BY \"%s\"", username, password)
+		if u.Spec.DefaultTablespace != "" {
+			stmt += " DEFAULT TABLESPACE " + strings.ToUpper(u.Spec.DefaultTablespace)
+		}
+		if u.Spec.TemporaryTablespace != "" {
+			stmt += " TEMPORARY TABLESPACE " + strings.ToUpper(u.Spec.TemporaryTablespace)
+		}
+		stmts = append(stmts, stmt+container)
+	} else {
+		if u.Status.PasswordSecretVersion != secret.ResourceVersion {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s IDENTIFIED BY \"%s\"%s", username, password, container))
+			rotated = u.Status.PasswordSecretVersion != ""
+		}
+		if u.Spec.DefaultTablespace != "" && !strings.EqualFold(u.Spec.DefaultTablespace, state.defaultTablespace) {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s DEFAULT TABLESPACE %s%s", username, strings.ToUpper(u.Spec.DefaultTablespace), container))
+		}
+		if u.Spec.TemporaryTablespace != "" && !strings.EqualFold(u.Spec.TemporaryTablespace, state.temporaryTablespace) {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s TEMPORARY TABLESPACE %s%s", username, strings.ToUpper(u.Spec.TemporaryTablespace), container))
+		}
+	}
+	for _, quota := range u.Spec.Quotas {
+		tablespace := strings.ToUpper(quota.Tablespace)
+		if state.quotas[tablespace] != quotaBytes(quota.Size) {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s QUOTA %s ON %s%s", username, strings.ToUpper(quota.Size), tablespace, container))
+		}
+	}
+	if locked := strings.Contains(state.accountStatus, "LOCKED"); u.Spec.AccountLocked != locked {
+		if u.Spec.AccountLocked {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s ACCOUNT LOCK%s", username, container))
+		} else if state.exists {
+			stmts = append(stmts, fmt.Sprintf("ALTER USER %s ACCOUNT UNLOCK%s", username, container))
+		}
+	}
+
+	var roles, systemPrivileges, objectPrivileges []string
+	for _, role := range u.Spec.Roles {
+		roles = append(roles, strings.ToUpper(string(role)))
+	}
+	for _, priv := range u.Spec.SystemPrivileges {
+		systemPrivileges = append(systemPrivileges, strings.ToUpper(string(priv)))
+	}
+	for _, grant := range u.Spec.ObjectGrants {
+		for _, priv := range grant.Privileges {
+			objectPrivileges = append(objectPrivileges, strings.ToUpper(string(priv))+" ON "+strings.ToUpper(grant.Object))
+		}
+	}
+	stmts = append(stmts, grantStatements(username, container, roles, u.Status.GrantedRoles, state.roles)...)
+	stmts = append(stmts, grantStatements(username, container, systemPrivileges, u.Status.GrantedSystemPrivileges, state.systemPrivileges)...)
+	stmts = append(stmts, grantStatements(username, container, objectPrivileges, u.Status.GrantedObjectPrivileges, state.objectPrivileges)...)
+
+	if len(stmts) > 0 {
+		cond := meta.FindStatusCondition(u.Status.Conditions, dbcommons.ConditionReady)
+		drifted := state.exists && cond != nil && cond.Status == metav1.ConditionTrue && cond.ObservedGeneration == u.Generation
+
+		log.Info("Syncing database user", "username", username, "statements", len(stmts))
+		script := strings.Join(stmts, ";\n") + ";"
+		if u.Spec.PdbName != "" {
+			script = fmt.Sprintf(dbcommons.SetContainerSQL, strings.ToUpper(u.Spec.PdbName)) + "\n" + script
+		}
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, true, "bash", "-c",
+			fmt.Sprintf(dbcommons.SQLPlusScriptCMD, script))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		if strings.Contains(out, "ORA-") {
+			r.Recorder.Event(u, corev1.EventTypeWarning, "UserSyncFailed", "Unable to sync user "+username+". Error log: \n"+out)
+			r.setDatabaseUserCondition(u, false, dbcommons.UserSyncFailedReason, strings.TrimSpace(out))
+			u.Status.Status = dbcommons.StatusError
+			return requeueY, nil
+		}
+		if !state.exists {
+			r.Recorder.Eventf(u, corev1.EventTypeNormal, "UserCreated", "User %s created", username)
+		} else if drifted && !rotated {
+			r.Recorder.Eventf(u, corev1.EventTypeNormal, "DriftCorrected", "User %s changed outside of the operator, %d statements applied", username, len(stmts))
+		}
+		if rotated {
+			r.Recorder.Eventf(u, corev1.EventTypeNormal, "PasswordRotated", "Password of user %s changed from secret %s", username, secret.Name)
+		}
+
+		if state, err = r.getDatabaseUserState(u, readyPod, ctx, req); err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+	}
+
+	u.Status.Sid = sidb.Status.Sid
+	u.Status.PdbName = strings.ToUpper(u.Spec.PdbName)
+	u.Status.PasswordSecretVersion = secret.ResourceVersion
+	u.Status.AccountStatus = state.accountStatus
+	u.Status.GrantedRoles = grantedOf(roles, state.roles)
+	u.Status.GrantedSystemPrivileges = grantedOf(systemPrivileges, state.systemPrivileges)
+	u.Status.GrantedObjectPrivileges = grantedOf(objectPrivileges, state.objectPrivileges)
+	u.Status.LastSyncTime = &metav1.Time{Time: time.Now()}
+	u.Status.Status = dbcommons.StatusReady
+	r.setDatabaseUserCondition(u, true, dbcommons.UserSyncedReason, "user "+username+" matches the spec")
+
+	return ctrl.Result{RequeueAfter: databaseUserResyncInterval}, nil
+}
+
+// Returns the grant statements for privileges missing from the database and the revoke
+// statements for privileges granted earlier by the operator and removed from the spec
+func grantStatements(username string, container string, desired []string, granted []string, current map[string]bool) []string {
+	var stmts []string
+	wanted := make(map[string]bool)
+	for _, priv := range desired {
+		wanted[priv] = true
+		if !current[priv] {
+			stmts = append(stmts, fmt.Sprintf("GRANT %s TO %s%s", priv, username, container))
+		}
+	}
+	for _, priv := range granted {
+		if !wanted[priv] && current[priv] {
+			stmts = append(stmts, fmt.Sprintf("REVOKE %s FROM %s%s", priv, username, container))
+		}
+	}
+	return stmts
+}
+
+// Returns the desired privileges which are granted in the database
+func grantedOf(desired []string, current map[string]bool) []string {
+	var granted []string
+	for _, priv := range desired {
+		if current[priv] {
+			granted = append(granted, priv)
+		}
+	}
+	return granted
+}
+
+// Converts a quota size to bytes as reported by DBA_TS_QUOTAS
+func quotaBytes(size string) string {
+	size = strings.ToUpper(size)
+	if size == "UNLIMITED" {
+		return size
+	}
+	multiplier := int64(1)
+	if size == "" {
+		return size
+	}
+	if unit := strings.IndexByte("KMGT", size[len(size)-1]); unit >= 0 {
+		multiplier <<= 10 * (unit + 1)
+		size = size[:len(size)-1]
+	}
+	value, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return size
+	}
+	return strconv.FormatInt(value*multiplier, 10)
+}
+
+// Reads the account, quotas, roles and privileges of the user from the data dictionary
+func (r *DatabaseUserReconciler) getDatabaseUserState(u *dbapi.DatabaseUser, readyPod corev1.Pod,
+	ctx context.Context, req ctrl.Request) (databaseUserState, error) {
+
+	state := databaseUserState{
+		quotas:           make(map[string]string),
+		roles:            make(map[string]bool),
+		systemPrivileges: make(map[string]bool),
+		objectPrivileges: make(map[string]bool),
+	}
+	script := fmt.Sprintf(dbcommons.GetDatabaseUserSQL, strings.ToUpper(u.Spec.Username))
+	if u.Spec.PdbName != "" {
+		script = fmt.Sprintf(dbcommons.SetContainerSQL, strings.ToUpper(u.Spec.PdbName)) + "\n" + script
+	}
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.SQLPlusScriptCMD, script))
+	if err != nil {
+		return state, err
+	}
+	if strings.Contains(out, "ORA-") {
+		return state, fmt.Errorf("unable to read user %s\n%s", u.Spec.Username, out)
+	}
+	if user := valuesWithPrefix(out, "user:"); len(user) > 0 {
+		fields := strings.Split(user[0], ":")
+		if len(fields) == 3 {
+			state.exists = true
+			state.accountStatus, state.defaultTablespace, state.temporaryTablespace = fields[0], fields[1], fields[2]
+		}
+	}
+	for _, quota := range valuesWithPrefix(out, "quota:") {
+		if tablespace, size, ok := strings.Cut(quota, ":"); ok {
+			state.quotas[tablespace] = size
+		}
+	}
+	for _, role := range valuesWithPrefix(out, "role:") {
+		state.roles[role] = true
+	}
+	for _, priv := range valuesWithPrefix(out, "syspriv:") {
+		state.systemPrivileges[priv] = true
+	}
+	for _, priv := range valuesWithPrefix(out, "objpriv:") {
+		state.objectPrivileges[priv] = true
+	}
+	return state, nil
+}
+
+// #############################################################################
+//
+//	Drop the user on deletion when the deletion policy is Delete
+//
+// #############################################################################
+func (r *DatabaseUserReconciler) manageDatabaseUserDeletion(u *dbapi.DatabaseUser, sidb *dbapi.SingleInstanceDatabase, sidbDeleted bool,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("manageDatabaseUserDeletion", req.NamespacedName)
+
+	if !controllerutil.ContainsFinalizer(u, databaseUserFinalizer) {
+		return requeueN, nil
+	}
+	if u.Spec.DeletionPolicy == dbcommons.DeletionPolicyDelete && !sidbDeleted && sidb.GetDeletionTimestamp() == nil {
+		if sidb.Status.Status != dbcommons.StatusReady {
+			log.Info("Database not ready, waiting to drop user", "database", sidb.Name)
+			return requeueY, nil
+		}
+		readyPod, err := GetDatabaseReadyPod(r, sidb, ctx, req)
+		if err != nil || readyPod.Name == "" {
+			return requeueY, err
+		}
+		script := fmt.Sprintf(dbcommons.DropDatabaseUserSQL, strings.ToUpper(u.Spec.Username))
+		if u.Spec.PdbName != "" {
+			script = fmt.Sprintf(dbcommons.SetContainerSQL, strings.ToUpper(u.Spec.PdbName)) + "\n" + script
+		}
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.SQLPlusScriptCMD, script))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		// ORA-01918: user does not exist
+		if strings.Contains(out, "ORA-") && !strings.Contains(out, "ORA-01918") {
+			r.Recorder.Event(u, corev1.EventTypeWarning, "UserDropFailed", "Unable to drop user. Error log: \n"+out)
+			return requeueY, nil
+		}
+		log.Info("Database user dropped", "username", u.Spec.Username)
+	}
+
+	controllerutil.RemoveFinalizer(u, databaseUserFinalizer)
+	if err := r.Update(ctx, u); err != nil {
+		return requeueY, err
+	}
+	return requeueN, nil
+}
+
+func (r *DatabaseUserReconciler) setDatabaseUserCondition(u *dbapi.DatabaseUser, ready bool, reason string, message string) {
+	status := metav1.ConditionFalse
+	if ready {
+		status = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&u.Status.Conditions, metav1.Condition{
+		Type:               dbcommons.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: u.Generation,
+	})
+}
+
+// Maps a secret to the users taking their password from it
+func (r *DatabaseUserReconciler) passwordSecretToDatabaseUsers(ctx context.Context, obj client.Object) []reconcile.Request {
+	users := &dbapi.DatabaseUserList{}
+	if err := r.List(ctx, users, client.InNamespace(obj.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, user := range users.Items {
+		if user.Spec.PasswordSecret.SecretName == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: user.Namespace, Name: user.Name}})
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DatabaseUserReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dbapi.DatabaseUser{}, builder.WithPredicates(dbcommons.ResourceEventHandler())).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.passwordSecretToDatabaseUsers), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
+		Complete(r)
+}
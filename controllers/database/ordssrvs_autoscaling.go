@@ -0,0 +1,279 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Target CPU utilization when no metric is set, as defaulted by the API server
+const defaultTargetCPUUtilizationPercentage int32 = 80
+
+/************************************************
+ * HorizontalPodAutoscaler
+ *************************************************/
+func (r *OrdsSrvsReconciler) AutoscalingReconcile(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("AutoscalingReconcile")
+
+	definedHPA := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err = r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}, definedHPA); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		definedHPA = nil
+	}
+
+	// Autoscaling removed or not supported by the workload
+	if ordssrvs.Spec.Autoscaling == nil || ordssrvs.Spec.WorkloadType == "DaemonSet" {
+		if definedHPA != nil {
+			if err := r.Delete(ctx, definedHPA); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			logr.Info("Deleted: HorizontalPodAutoscaler")
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Delete", "HorizontalPodAutoscaler %s Deleted", ordssrvs.Name)
+		}
+		return nil
+	}
+
+	desiredHPA := r.HorizontalPodAutoscalerDefine(ctx, ordssrvs)
+	if err := ctrl.SetControllerReference(ordssrvs, desiredHPA, r.Scheme); err != nil {
+		return err
+	}
+
+	if definedHPA == nil {
+		if err := r.Create(ctx, desiredHPA); err != nil {
+			return err
+		}
+		logr.Info("Created: HorizontalPodAutoscaler")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Create", "HorizontalPodAutoscaler %s Created", ordssrvs.Name)
+		return nil
+	}
+
+	// Only the fields set by the operator are compared, the API server defaults the others
+	if !equality.Semantic.DeepEqual(definedHPA.Spec.ScaleTargetRef, desiredHPA.Spec.ScaleTargetRef) ||
+		!equality.Semantic.DeepEqual(definedHPA.Spec.MinReplicas, desiredHPA.Spec.MinReplicas) ||
+		definedHPA.Spec.MaxReplicas != desiredHPA.Spec.MaxReplicas ||
+		!equality.Semantic.DeepEqual(definedHPA.Spec.Metrics, desiredHPA.Spec.Metrics) {
+		definedHPA.Spec.ScaleTargetRef = desiredHPA.Spec.ScaleTargetRef
+		definedHPA.Spec.MinReplicas = desiredHPA.Spec.MinReplicas
+		definedHPA.Spec.MaxReplicas = desiredHPA.Spec.MaxReplicas
+		definedHPA.Spec.Metrics = desiredHPA.Spec.Metrics
+		if err := r.Update(ctx, definedHPA); err != nil {
+			return err
+		}
+		logr.Info("Updated: HorizontalPodAutoscaler")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Update", "HorizontalPodAutoscaler %s Updated", ordssrvs.Name)
+	}
+	return nil
+}
+
+func (r *OrdsSrvsReconciler) HorizontalPodAutoscalerDefine(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) *autoscalingv2.HorizontalPodAutoscaler {
+	autoscaling := ordssrvs.Spec.Autoscaling
+
+	kind := "Deployment"
+	if ordssrvs.Spec.WorkloadType == "StatefulSet" {
+		kind = "StatefulSet"
+	}
+
+	targetCPUUtilizationPercentage := autoscaling.TargetCPUUtilizationPercentage
+	if targetCPUUtilizationPercentage == nil && len(autoscaling.Metrics) == 0 {
+		defaultTarget := defaultTargetCPUUtilizationPercentage
+		targetCPUUtilizationPercentage = &defaultTarget
+	}
+
+	var metrics []autoscalingv2.MetricSpec
+	if targetCPUUtilizationPercentage != nil {
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: targetCPUUtilizationPercentage,
+				},
+			},
+		})
+	}
+	for i := range autoscaling.Metrics {
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: autoscaling.Metrics[i].Name,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &autoscaling.Metrics[i].TargetAverageValue,
+				},
+			},
+		})
+	}
+
+	minReplicas := autoscaling.MinReplicas
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: objectMetaDefine(ordssrvs, ordssrvs.Name),
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       kind,
+				Name:       ordssrvs.Name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+}
+
+/************************************************
+ * PodDisruptionBudget
+ *************************************************/
+func (r *OrdsSrvsReconciler) PodDisruptionBudgetReconcile(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("PodDisruptionBudgetReconcile")
+
+	definedPDB := &policyv1.PodDisruptionBudget{}
+	if err = r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}, definedPDB); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		definedPDB = nil
+	}
+
+	desiredPDB := r.PodDisruptionBudgetDefine(ctx, ordssrvs)
+	if desiredPDB == nil {
+		if definedPDB != nil {
+			if err := r.Delete(ctx, definedPDB); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			logr.Info("Deleted: PodDisruptionBudget")
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Delete", "PodDisruptionBudget %s Deleted", ordssrvs.Name)
+		}
+		return nil
+	}
+	if err := ctrl.SetControllerReference(ordssrvs, desiredPDB, r.Scheme); err != nil {
+		return err
+	}
+
+	if definedPDB == nil {
+		if err := r.Create(ctx, desiredPDB); err != nil {
+			return err
+		}
+		logr.Info("Created: PodDisruptionBudget")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Create", "PodDisruptionBudget %s Created", ordssrvs.Name)
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(definedPDB.Spec, desiredPDB.Spec) {
+		definedPDB.Spec = desiredPDB.Spec
+		if err := r.Update(ctx, definedPDB); err != nil {
+			return err
+		}
+		logr.Info("Updated: PodDisruptionBudget")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Update", "PodDisruptionBudget %s Updated", ordssrvs.Name)
+	}
+	return nil
+}
+
+// Returns nil when no PodDisruptionBudget is required
+func (r *OrdsSrvsReconciler) PodDisruptionBudgetDefine(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) *policyv1.PodDisruptionBudget {
+	pdb := ordssrvs.Spec.PodDisruptionBudget
+	if pdb != nil && pdb.Enabled != nil && !*pdb.Enabled {
+		return nil
+	}
+
+	// A single replica cannot survive a drain with the default budget
+	if pdb == nil || (pdb.MinAvailable == nil && pdb.MaxUnavailable == nil) {
+		replicas := ordssrvs.Spec.Replicas
+		if ordssrvs.Spec.Autoscaling != nil {
+			replicas = ordssrvs.Spec.Autoscaling.MinReplicas
+		}
+		if ordssrvs.Spec.WorkloadType == "DaemonSet" || replicas < 2 {
+			return nil
+		}
+	}
+
+	selector := selectorDefine(ordssrvs)
+	spec := policyv1.PodDisruptionBudgetSpec{
+		Selector: &selector,
+	}
+	switch {
+	case pdb != nil && pdb.MinAvailable != nil:
+		spec.MinAvailable = pdb.MinAvailable
+	case pdb != nil && pdb.MaxUnavailable != nil:
+		spec.MaxUnavailable = pdb.MaxUnavailable
+	default:
+		maxUnavailable := intstr.FromInt32(1)
+		spec.MaxUnavailable = &maxUnavailable
+	}
+
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: objectMetaDefine(ordssrvs, ordssrvs.Name),
+		Spec:       spec,
+	}
+}
+
+// Keeps the replicas set by the HorizontalPodAutoscaler when the workload is updated
+func autoscaledReplicas(ordssrvs *dbapi.OrdsSrvs, definedWorkload client.Object, desiredWorkload client.Object) {
+	if ordssrvs.Spec.Autoscaling == nil {
+		return
+	}
+	switch desired := desiredWorkload.(type) {
+	case *appsv1.Deployment:
+		if defined, ok := definedWorkload.(*appsv1.Deployment); ok && defined.Spec.Replicas != nil {
+			desired.Spec.Replicas = defined.Spec.Replicas
+		}
+	case *appsv1.StatefulSet:
+		if defined, ok := definedWorkload.(*appsv1.StatefulSet); ok && defined.Spec.Replicas != nil {
+			desired.Spec.Replicas = defined.Spec.Replicas
+		}
+	}
+}
@@ -51,7 +51,10 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -60,6 +63,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -105,6 +109,7 @@ type OrdsSrvsReconciler struct {
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
 	Log      logr.Logger
+	Config   *rest.Config
 
  	ordssrvsScriptsConfigMapName string
     ordssrvsGlobalSettingsConfigMapName string
@@ -113,6 +118,9 @@ type OrdsSrvsReconciler struct {
 
     // Trigger a restart of Pods on Config Changes
     RestartPods bool
+
+    // Version of the Global and Pool ConfigMaps mounted by the pods, when rolling config updates are enabled
+    configVersion string
 }
 
 //+kubebuilder:rbac:groups=database.oracle.com,resources=ordssrvs,verbs=get;list;watch;create;update;patch;delete
@@ -121,7 +129,7 @@ type OrdsSrvsReconciler struct {
 //+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=configmaps/status,verbs=get;update;patch
-//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=secrets/status,verbs=get
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=services/status,verbs=get;update;patch
@@ -133,6 +141,14 @@ type OrdsSrvsReconciler struct {
 //+kubebuilder:rbac:groups=core,resources=daemonsets/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=statefulsets/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=httproutes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=gateway.networking.k8s.io,resources=gateways,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
+//+kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
+//+kubebuilder:rbac:groups=core,resources=serviceaccounts/token,verbs=create
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *OrdsSrvsReconciler) SetupWithManager(mgr ctrl.Manager) error {
@@ -145,6 +161,9 @@ func (r *OrdsSrvsReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&corev1.Service{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
+		Owns(&autoscalingv2.HorizontalPodAutoscaler{}).
+		Owns(&policyv1.PodDisruptionBudget{}).
+		Owns(&networkingv1.Ingress{}).
 		Complete(r)
 }
 
@@ -203,6 +222,10 @@ func (r *OrdsSrvsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	r.ordssrvsScriptsConfigMapName = ordssrvs.Name + "-scripts-config-map"
 	r.ordssrvsGlobalSettingsConfigMapName = ordssrvs.Name + "-global-settings-config-map"
 
+	// Versioned ConfigMaps for rolling config updates
+	stageConfigMaps := r.ConfigVersionDefine(ctx, ordssrvs)
+	r.ordssrvsGlobalSettingsConfigMapName = r.versionedConfigMapName(r.ordssrvsGlobalSettingsConfigMapName)
+
 	// ConfigMap - Scripts
 	if err := r.ConfigMapReconcile(ctx, ordssrvs, r.ordssrvsScriptsConfigMapName, 0); err != nil {
 		logger.Error(err, "Error in ConfigMapReconcile (init-script)")
@@ -220,20 +243,25 @@ func (r *OrdsSrvsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// ConfigMap - Global Settings
-	if err := r.ConfigMapReconcile(ctx, ordssrvs, r.ordssrvsGlobalSettingsConfigMapName, 0); err != nil {
-		logger.Error(err, "Error in ConfigMapReconcile (Global)")
-		return ctrl.Result{}, err
+	if stageConfigMaps {
+		if err := r.ConfigMapReconcile(ctx, ordssrvs, r.ordssrvsGlobalSettingsConfigMapName, 0); err != nil {
+			logger.Error(err, "Error in ConfigMapReconcile (Global)")
+			return ctrl.Result{}, err
+		}
 	}
 
 	// ConfigMap - Pool Settings
 	definedPools := make(map[string]bool)
 	for i := 0; i < len(ordssrvs.Spec.PoolSettings); i++ {
 		poolName := strings.ToLower(ordssrvs.Spec.PoolSettings[i].PoolName)
-		poolConfigMapName := ordssrvs.Name + "-cfg-pool-" + poolName
+		poolConfigMapName := r.versionedConfigMapName(ordssrvs.Name + "-cfg-pool-" + poolName)
 		if definedPools[poolConfigMapName] {
 			return ctrl.Result{}, errors.New("poolName: " + poolName + " is not unique")
 		}
 		definedPools[poolConfigMapName] = true
+		if !stageConfigMaps {
+			continue
+		}
 		if err := r.ConfigMapReconcile(ctx, ordssrvs, poolConfigMapName, i); err != nil {
 			logger.Error(err, "Error in ConfigMapReconcile (Pools)")
 			return ctrl.Result{}, err
@@ -243,6 +271,28 @@ func (r *OrdsSrvsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		logger.Error(err, "Error in ConfigMapDelete (Pools)")
 		return ctrl.Result{}, err
 	}
+
+	// The rolling update of the workload restarts the pods on versioned ConfigMap changes
+	if ordssrvs.Spec.RollingConfigUpdate != nil {
+		r.RestartPods = false
+	}
+
+	// Secrets - Vault
+	if err := r.VaultSecretsReconcile(ctx, ordssrvs); err != nil {
+		logger.Error(err, "Error in VaultSecretsReconcile")
+		r.Recorder.Event(ordssrvs, corev1.EventTypeWarning, "VaultError", err.Error())
+		condition := metav1.Condition{Type: typeVaultSyncedORDS, Status: metav1.ConditionFalse, Reason: "VaultError", Message: err.Error()}
+		if statusErr := r.SetStatus(ctx, req, ordssrvs, condition); statusErr != nil {
+			logger.Error(statusErr, "Failed to update Status")
+		}
+		return ctrl.Result{}, err
+	}
+	if meta.IsStatusConditionFalse(ordssrvs.Status.Conditions, typeVaultSyncedORDS) {
+		condition := metav1.Condition{Type: typeVaultSyncedORDS, Status: metav1.ConditionTrue, Reason: "VaultSynced", Message: "Vault secrets synchronized"}
+		if err := r.SetStatus(ctx, req, ordssrvs, condition); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
 	if err := r.Get(ctx, req.NamespacedName, ordssrvs); err != nil {
 		logger.Error(err, "Failed to re-fetch")
 		return ctrl.Result{}, err
@@ -265,17 +315,44 @@ func (r *OrdsSrvsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		logger.Error(err, "Error in WorkloadDelete")
 		return ctrl.Result{}, err
 	}
+
+	// Rolling config update
+	rolloutRequeueAfter, err := r.ConfigRolloutReconcile(ctx, req, ordssrvs)
+	if err != nil {
+		logger.Error(err, "Error in ConfigRolloutReconcile")
+		return ctrl.Result{}, err
+	}
 	if err := r.Get(ctx, req.NamespacedName, ordssrvs); err != nil {
 		logger.Error(err, "Failed to re-fetch")
 		return ctrl.Result{}, err
 	}
 
+	// HorizontalPodAutoscaler and PodDisruptionBudget
+	if err := r.AutoscalingReconcile(ctx, ordssrvs); err != nil {
+		logger.Error(err, "Error in AutoscalingReconcile")
+		return ctrl.Result{}, err
+	}
+	if err := r.PodDisruptionBudgetReconcile(ctx, ordssrvs); err != nil {
+		logger.Error(err, "Error in PodDisruptionBudgetReconcile")
+		return ctrl.Result{}, err
+	}
+
 	// Service
 	if err := r.ServiceReconcile(ctx, ordssrvs); err != nil {
 		logger.Error(err, "Error in ServiceReconcile")
 		return ctrl.Result{}, err
 	}
 
+	// Ingress or HTTPRoute
+	if err := r.IngressReconcile(ctx, ordssrvs); err != nil {
+		logger.Error(err, "Error in IngressReconcile")
+		return ctrl.Result{}, err
+	}
+	if err := r.HTTPRouteReconcile(ctx, ordssrvs); err != nil {
+		logger.Error(err, "Error in HTTPRouteReconcile")
+		return ctrl.Result{}, err
+	}
+
 	// Set the Type as Available when a pod restart is not required
 	if !r.RestartPods {
 		condition := metav1.Condition{Type: typeAvailableORDS, Status: metav1.ConditionTrue, Reason: "Available", Message: "Workload in Sync"}
@@ -283,12 +360,28 @@ func (r *OrdsSrvsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 			return ctrl.Result{}, err
 		}
 	}
+	// Pool Health
+	if err := r.PoolHealthReconcile(ctx, req, ordssrvs); err != nil {
+		logger.Error(err, "Error in PoolHealthReconcile")
+		return ctrl.Result{}, err
+	}
 	if err := r.Get(ctx, req.NamespacedName, ordssrvs); err != nil {
 		logger.Error(err, "Failed to re-fetch")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	// Schedule the next pool check and Vault synchronization
+	var requeueAfter time.Duration
+	if interval := poolHealthCheckInterval(ordssrvs); interval > 0 && len(ordssrvs.Spec.PoolSettings) > 0 {
+		requeueAfter = interval
+	}
+	if interval := vaultSyncInterval(ordssrvs); interval > 0 && (requeueAfter == 0 || interval < requeueAfter) {
+		requeueAfter = interval
+	}
+	if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 /************************************************
@@ -354,6 +447,7 @@ func (r *OrdsSrvsReconciler) SetStatus(ctx context.Context, req ctrl.Request, or
 	ords.Status.HTTPSPort = ords.Spec.GlobalSettings.StandaloneHTTPSPort
 	ords.Status.MongoPort = mongoPort
 	ords.Status.RestartRequired = r.RestartPods
+	ords.Status.ExternalURL = r.ExternalURLDefine(ctx, ords)
 	if err := r.Status().Update(ctx, ords); err != nil {
 		logr.Error(err, "Failed to update Status")
 		return err
@@ -480,12 +574,18 @@ func (r *OrdsSrvsReconciler) WorkloadReconcile(ctx context.Context, req ctrl.Req
 
 	var ProgressDeadlineSeconds int32 = 3600
 
+	// Initial replicas when the HorizontalPodAutoscaler manages the workload
+	replicas := ordssrvs.Spec.Replicas
+	if ordssrvs.Spec.Autoscaling != nil {
+		replicas = ordssrvs.Spec.Autoscaling.MinReplicas
+	}
+
 	switch kind {
 	case "StatefulSet":
 		desiredWorkload = &appsv1.StatefulSet{
 			ObjectMeta: objectMeta,
 			Spec: appsv1.StatefulSetSpec{
-				Replicas: &ordssrvs.Spec.Replicas,
+				Replicas: &replicas,
 				Selector: &selector,
 				Template: template,
 			},
@@ -500,18 +600,20 @@ func (r *OrdsSrvsReconciler) WorkloadReconcile(ctx context.Context, req ctrl.Req
 				Template: template,
 			},
 		}
+		rollingUpdateDefine(ordssrvs, desiredWorkload)
 		desiredSpecHash = generateSpecHash(desiredWorkload.(*appsv1.DaemonSet).Spec)
 		desiredWorkload.(*appsv1.DaemonSet).ObjectMeta.Labels[specHashLabel] = desiredSpecHash
 	default:
 		desiredWorkload = &appsv1.Deployment{
 			ObjectMeta: objectMeta,
 			Spec: appsv1.DeploymentSpec{
-				Replicas:                &ordssrvs.Spec.Replicas,
+				Replicas:                &replicas,
 				Selector:                &selector,
 				Template:                template,
 				ProgressDeadlineSeconds: &ProgressDeadlineSeconds,
 			},
 		}
+		rollingUpdateDefine(ordssrvs, desiredWorkload)
 		desiredSpecHash = generateSpecHash(desiredWorkload.(*appsv1.Deployment).Spec)
 		desiredWorkload.(*appsv1.Deployment).ObjectMeta.Labels[specHashLabel] = desiredSpecHash
 	}
@@ -545,6 +647,8 @@ func (r *OrdsSrvsReconciler) WorkloadReconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 
+	autoscaledReplicas(ordssrvs, definedWorkload, desiredWorkload)
+
 	definedLabelsField := reflect.ValueOf(definedWorkload).Elem().FieldByName("ObjectMeta").FieldByName("Labels")
 	if definedLabelsField.IsValid() {
 		specHashValue := definedLabelsField.MapIndex(reflect.ValueOf(specHashLabel))
@@ -560,7 +664,8 @@ func (r *OrdsSrvsReconciler) WorkloadReconcile(ctx context.Context, req ctrl.Req
 		if err := r.Client.Update(ctx, desiredWorkload); err != nil {
 			return err
 		}
-		r.RestartPods = true
+		// the rolling update of the workload already restarts the pods
+		r.RestartPods = ordssrvs.Spec.RollingConfigUpdate == nil
 		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Update", "Updated %s", kind)
 	}
 
@@ -721,7 +826,9 @@ func (r *OrdsSrvsReconciler) podTemplateSpecDefine(ords *dbapi.OrdsSrvs, ctx con
 					Command:         []string{"/bin/bash", "-c", ordsSABase + "/scripts/ords_start.sh"},
 					// DEBUG mode, change to false
 					Env:          r.envDefine(ords, true, ctx),
-					VolumeMounts: specVolumeMounts,
+					VolumeMounts:   specVolumeMounts,
+					Resources:      resourcesDefine(ords),
+					ReadinessProbe: readinessProbeDefine(ords),
 				}},
 				ServiceAccountName: ords.Spec.ServiceAccountName,
 			},
@@ -813,7 +920,14 @@ func (r *OrdsSrvsReconciler) VolumesDefine(ctx context.Context, ordssrvs *dbapi.
 		poolName := strings.ToLower(ordssrvs.Spec.PoolSettings[i].PoolName)
 
 		// /opt/oracle/sa/config/databases/POOL/
-		poolConfigName := ordssrvs.Name+"-cfg-pool-" + poolName
+		poolConfigName := r.versionedConfigMapName(ordssrvs.Name+"-cfg-pool-" + poolName)
+
+		// pools added after the running configuration version are not mounted until rolled out
+		if !r.configMapExists(ctx, ordssrvs, poolConfigName) {
+			logger.Info("Skipping pool " + poolName + ", ConfigMap " + poolConfigName + " not staged")
+			continue
+		}
+
 		poolConfigVolume := configMapvolumeBuild(poolConfigName, poolConfigName)
 		poolConfigVolumeMount := volumeMountBuild(poolConfigName, ordsSABase+"/config/databases/"+poolName+"/", true)
 		volumes = append(volumes, poolConfigVolume)
@@ -964,6 +1078,13 @@ func (r *OrdsSrvsReconciler) ServiceDefine(ctx context.Context, ordssrvs *dbapi.
 	return def
 }
 
+func resourcesDefine(ords *dbapi.OrdsSrvs) corev1.ResourceRequirements {
+	if ords.Spec.Resources == nil {
+		return corev1.ResourceRequirements{}
+	}
+	return *ords.Spec.Resources
+}
+
 func podSecurityContextDefine() *corev1.PodSecurityContext {
 
 	return &corev1.PodSecurityContext{
@@ -1034,6 +1155,12 @@ func (r *OrdsSrvsReconciler) addSecretEnvVar(envVars []corev1.EnvVar, ordssrvs *
 	return append(envVars, newEnvVar)
 }
 
+// Vault passwords are not encrypted with encPrivKey, the init script does not decrypt them
+func (r *OrdsSrvsReconciler) addVaultSecretEnvVar(envVars []corev1.EnvVar, ordssrvs *dbapi.OrdsSrvs, envName string, poolName string, secretKey string, ctx context.Context) []corev1.EnvVar {
+	envVars = r.addSecretEnvVar(envVars, ordssrvs, envName, vaultSecretName(ordssrvs, poolName), secretKey, ctx)
+	return addEnvVar(envVars, envName+"_vault", "true")
+}
+
 // Sets environment variables in the containers
 func (r *OrdsSrvsReconciler) envDefine(ordssrvs *dbapi.OrdsSrvs, initContainer bool, ctx context.Context) []corev1.EnvVar {
 	logger := log.FromContext(ctx).WithName("envDefine")
@@ -1099,7 +1226,9 @@ func (r *OrdsSrvsReconciler) envDefine(ordssrvs *dbapi.OrdsSrvs, initContainer b
 				// dbpassword
 				envName := poolName + "_dbpassword"
 				// it can be provided by a wallet
-				if ordssrvs.Spec.PoolSettings[i].DBSecret.SecretName != "" {
+				if vaultSecrets := ordssrvs.Spec.PoolSettings[i].VaultSecrets; vaultSecrets != nil && vaultSecrets.DBPassword != nil {
+					envVars = r.addVaultSecretEnvVar(envVars, ordssrvs, envName, ordssrvs.Spec.PoolSettings[i].PoolName, vaultKeyDBPassword, ctx)
+				} else if ordssrvs.Spec.PoolSettings[i].DBSecret.SecretName != "" {
 				secretName := ordssrvs.Spec.PoolSettings[i].DBSecret.SecretName
 				secretKey := ordssrvs.Spec.PoolSettings[i].DBSecret.PasswordKey
 				envVars = r.addSecretEnvVar(envVars, ordssrvs, envName, secretName, secretKey, ctx)
@@ -1115,7 +1244,9 @@ func (r *OrdsSrvsReconciler) envDefine(ordssrvs *dbapi.OrdsSrvs, initContainer b
 				envVars = addEnvVar(envVars, poolName+"_autoupgrade_apex", strconv.FormatBool(ordssrvs.Spec.PoolSettings[i].AutoUpgradeAPEX))
 
 				// dbadminuserpassword
-				if ordssrvs.Spec.PoolSettings[i].DBAdminUserSecret.SecretName != "" {
+				if vaultSecrets := ordssrvs.Spec.PoolSettings[i].VaultSecrets; vaultSecrets != nil && vaultSecrets.DBAdminUserPassword != nil {
+					envVars = r.addVaultSecretEnvVar(envVars, ordssrvs, poolName+"_dbadminuserpassword", ordssrvs.Spec.PoolSettings[i].PoolName, vaultKeyDBAdminUserPassword, ctx)
+				} else if ordssrvs.Spec.PoolSettings[i].DBAdminUserSecret.SecretName != "" {
 					envName := poolName + "_dbadminuserpassword"
 					secretName := ordssrvs.Spec.PoolSettings[i].DBAdminUserSecret.SecretName
 					secretKey := ordssrvs.Spec.PoolSettings[i].DBAdminUserSecret.PasswordKey
@@ -1129,7 +1260,9 @@ func (r *OrdsSrvsReconciler) envDefine(ordssrvs *dbapi.OrdsSrvs, initContainer b
 				envVars = addEnvVar(envVars, poolName + "_dbcdbadminuser", ordssrvs.Spec.PoolSettings[i].DBCDBAdminUser)
 
 				// dbcdbadminuserpassword
-				if ordssrvs.Spec.PoolSettings[i].DBCDBAdminUserSecret.SecretName != "" {
+				if vaultSecrets := ordssrvs.Spec.PoolSettings[i].VaultSecrets; vaultSecrets != nil && vaultSecrets.DBCDBAdminUserPassword != nil {
+					envVars = r.addVaultSecretEnvVar(envVars, ordssrvs, poolName+"_dbcdbadminuserpassword", ordssrvs.Spec.PoolSettings[i].PoolName, vaultKeyDBCDBAdminUserPassword, ctx)
+				} else if ordssrvs.Spec.PoolSettings[i].DBCDBAdminUserSecret.SecretName != "" {
 					envName := poolName + "_dbcdbadminuserpassword"
 					secretName := ordssrvs.Spec.PoolSettings[i].DBCDBAdminUserSecret.SecretName
 					secretKey := ordssrvs.Spec.PoolSettings[i].DBCDBAdminUserSecret.PasswordKey
@@ -1238,6 +1371,11 @@ func (r *OrdsSrvsReconciler) ConfigMapDelete(ctx context.Context, req ctrl.Reque
 			continue
 		}
 
+		// keep the versioned config maps of the running configuration for rollbacks
+		if isRetainedConfigMap(ordssrvs, configMap.Name) {
+			continue
+		}
+
 		if _, exists := definedPools[configMap.Name]; !exists {
 			if err := r.Delete(ctx, &configMap); err != nil {
 				return err
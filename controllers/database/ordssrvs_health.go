@@ -0,0 +1,203 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// typeDegradedORDS represents the status used when at least one pool cannot connect to its database
+const typeDegradedORDS = "Degraded"
+
+/************************************************
+ * Pool Health
+ *************************************************/
+// Returns the interval between pool checks, 0 when the checks are disabled
+func poolHealthCheckInterval(ordssrvs *dbapi.OrdsSrvs) time.Duration {
+	if ordssrvs.Spec.PoolHealthCheckInterval == nil {
+		return 300 * time.Second
+	}
+	return time.Duration(*ordssrvs.Spec.PoolHealthCheckInterval) * time.Second
+}
+
+func (r *OrdsSrvsReconciler) PoolHealthReconcile(ctx context.Context, req ctrl.Request, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("PoolHealthReconcile")
+
+	interval := poolHealthCheckInterval(ordssrvs)
+	if interval == 0 || len(ordssrvs.Spec.PoolSettings) == 0 {
+		return nil
+	}
+
+	// Skip until the interval elapsed, unless the pools changed
+	lastCheck := time.Time{}
+	checkedPools := len(ordssrvs.Status.Pools) == len(ordssrvs.Spec.PoolSettings)
+	for _, poolStatus := range ordssrvs.Status.Pools {
+		if poolStatus.LastCheckTime == nil {
+			checkedPools = false
+		} else if lastCheck.IsZero() || poolStatus.LastCheckTime.Time.Before(lastCheck) {
+			lastCheck = poolStatus.LastCheckTime.Time
+		}
+	}
+	if checkedPools && time.Since(lastCheck) < interval {
+		return nil
+	}
+
+	readyPod, err := r.getOrdsReadyPod(ctx, ordssrvs)
+	if err != nil {
+		return err
+	}
+	if readyPod == nil {
+		logr.Info("No ORDS pod ready, skipping pool checks")
+		return nil
+	}
+
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, ordssrvs.Name, ctx, req, false,
+		"bash", "-c", ordsSABase+"/scripts/ords_init.sh --pool-status")
+	if err != nil {
+		logr.Error(err, "Failed to check pools in pod "+readyPod.Name)
+		return nil
+	}
+	results := parsePoolStatus(out)
+
+	now := metav1.Now()
+	var pools []dbapi.OrdsSrvsPoolStatus
+	var failedPools, uncheckedPools []string
+	for _, poolSettings := range ordssrvs.Spec.PoolSettings {
+		poolStatus, found := lookupPoolStatus(results, poolSettings.PoolName)
+		if !found {
+			poolStatus = dbapi.OrdsSrvsPoolStatus{
+				PoolName:  poolSettings.PoolName,
+				LastError: "pool not found in the ORDS configuration of pod " + readyPod.Name,
+			}
+		}
+		poolStatus.LastCheckTime = &now
+		if poolStatus.Unchecked {
+			uncheckedPools = append(uncheckedPools, poolStatus.PoolName)
+		} else if !poolStatus.Validated {
+			failedPools = append(failedPools, poolStatus.PoolName)
+		}
+		pools = append(pools, poolStatus)
+	}
+
+	condition := metav1.Condition{Type: typeDegradedORDS, Status: metav1.ConditionFalse, Reason: "PoolsValidated", Message: "All pools connected to their database"}
+	if len(uncheckedPools) > 0 {
+		condition.Reason = "PoolsNotChecked"
+		condition.Message = "Pools not checked, their database connect string is not known: " + strings.Join(uncheckedPools, ", ")
+	}
+	if len(failedPools) > 0 {
+		condition = metav1.Condition{Type: typeDegradedORDS, Status: metav1.ConditionTrue, Reason: "PoolCheckFailed",
+			Message: "Pools failed to connect to their database: " + strings.Join(failedPools, ", ")}
+	}
+
+	if err := r.Get(ctx, req.NamespacedName, ordssrvs); err != nil {
+		return err
+	}
+	if len(failedPools) > 0 && !meta.IsStatusConditionTrue(ordssrvs.Status.Conditions, typeDegradedORDS) {
+		r.Recorder.Event(ordssrvs, corev1.EventTypeWarning, "Degraded", condition.Message)
+	}
+	ordssrvs.Status.Pools = pools
+	meta.SetStatusCondition(&ordssrvs.Status.Conditions, condition)
+	if err := r.Status().Update(ctx, ordssrvs); err != nil {
+		logr.Error(err, "Failed to update Status")
+		return err
+	}
+	return nil
+}
+
+// Parses the POOLSTATUS lines of ords_init.sh --pool-status
+func parsePoolStatus(out string) map[string]dbapi.OrdsSrvsPoolStatus {
+	results := make(map[string]dbapi.OrdsSrvsPoolStatus)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.SplitN(strings.TrimSpace(line), "|", 6)
+		if len(fields) != 6 || fields[0] != "POOLSTATUS" {
+			continue
+		}
+		results[fields[1]] = dbapi.OrdsSrvsPoolStatus{
+			PoolName:    fields[1],
+			Validated:   fields[2] == "OK",
+			Unchecked:   fields[2] == "UNCHECKED",
+			ORDSVersion: fields[3],
+			APEXVersion: fields[4],
+			LastError:   fields[5],
+		}
+	}
+	return results
+}
+
+// Returns the check result of a pool of the spec, ords_init.sh reports the directory of the pool, named after the lowercased pool name
+func lookupPoolStatus(results map[string]dbapi.OrdsSrvsPoolStatus, poolName string) (dbapi.OrdsSrvsPoolStatus, bool) {
+	poolStatus, found := results[strings.ToLower(poolName)]
+	if !found {
+		return poolStatus, false
+	}
+	poolStatus.PoolName = poolName
+	return poolStatus, true
+}
+
+// Returns a running ORDS pod with all containers ready, nil if none
+func (r *OrdsSrvsReconciler) getOrdsReadyPod(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(ordssrvs.Namespace), client.MatchingLabels(getLabels(ordssrvs.Name))); err != nil {
+		return nil, fmt.Errorf("failed to list ORDS pods: %w", err)
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				return pod, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,159 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"reflect"
+	"testing"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+)
+
+func TestParsePoolStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		out  string
+		want map[string]dbapi.OrdsSrvsPoolStatus
+	}{
+		{
+			name: "validated pool",
+			out:  "POOLSTATUS|pdb1|OK|25.1.0.100.1652|24.2.0|\n",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"pdb1": {PoolName: "pdb1", Validated: true, ORDSVersion: "25.1.0.100.1652", APEXVersion: "24.2.0"},
+			},
+		},
+		{
+			name: "apex not installed",
+			out:  "POOLSTATUS|pdb1|OK|25.1.0.100.1652|NotInstalled|",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"pdb1": {PoolName: "pdb1", Validated: true, ORDSVersion: "25.1.0.100.1652", APEXVersion: "NotInstalled"},
+			},
+		},
+		{
+			name: "failed pool",
+			out:  "POOLSTATUS|pdb2|ERROR|||ORA-28000: The account is locked.",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"pdb2": {PoolName: "pdb2", LastError: "ORA-28000: The account is locked."},
+			},
+		},
+		{
+			name: "error with separator",
+			out:  "POOLSTATUS|pdb2|ERROR|||SQLERROR | exit",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"pdb2": {PoolName: "pdb2", LastError: "SQLERROR | exit"},
+			},
+		},
+		{
+			name: "unchecked pool",
+			out:  "POOLSTATUS|ccm|UNCHECKED|||Unable to get database connect string, pool not checked",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"ccm": {PoolName: "ccm", Unchecked: true, LastError: "Unable to get database connect string, pool not checked"},
+			},
+		},
+		{
+			name: "several pools among other output",
+			out: "Picked up JAVA_TOOL_OPTIONS\n" +
+				"  POOLSTATUS|pdb1|OK|25.1.0|24.2.0|  \r\n" +
+				"POOLSTATUS|pdb2|ERROR|||SQL check FAILED\n",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{
+				"pdb1": {PoolName: "pdb1", Validated: true, ORDSVersion: "25.1.0", APEXVersion: "24.2.0"},
+				"pdb2": {PoolName: "pdb2", LastError: "SQL check FAILED"},
+			},
+		},
+		{
+			name: "truncated line",
+			out:  "POOLSTATUS|pdb1|OK|25.1.0",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{},
+		},
+		{
+			name: "no output",
+			out:  "",
+			want: map[string]dbapi.OrdsSrvsPoolStatus{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parsePoolStatus(tt.out); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsePoolStatus() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLookupPoolStatus(t *testing.T) {
+	results := parsePoolStatus("POOLSTATUS|pdb1|OK|25.1.0|24.2.0|\nPOOLSTATUS|pdb2|ERROR|||ORA-01017: invalid username/password\n")
+	tests := []struct {
+		name     string
+		poolName string
+		want     dbapi.OrdsSrvsPoolStatus
+		found    bool
+	}{
+		{
+			name:     "lowercase pool",
+			poolName: "pdb1",
+			want:     dbapi.OrdsSrvsPoolStatus{PoolName: "pdb1", Validated: true, ORDSVersion: "25.1.0", APEXVersion: "24.2.0"},
+			found:    true,
+		},
+		{
+			name:     "uppercase pool",
+			poolName: "PDB1",
+			want:     dbapi.OrdsSrvsPoolStatus{PoolName: "PDB1", Validated: true, ORDSVersion: "25.1.0", APEXVersion: "24.2.0"},
+			found:    true,
+		},
+		{
+			name:     "mixed case failed pool",
+			poolName: "Pdb2",
+			want:     dbapi.OrdsSrvsPoolStatus{PoolName: "Pdb2", LastError: "ORA-01017: invalid username/password"},
+			found:    true,
+		},
+		{
+			name:     "pool not reported",
+			poolName: "PDB3",
+			found:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := lookupPoolStatus(results, tt.poolName)
+			if found != tt.found || (found && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("lookupPoolStatus(%q) = %+v, %v, want %+v, %v", tt.poolName, got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
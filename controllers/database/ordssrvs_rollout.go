@@ -0,0 +1,346 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Interval between checks of a rolling config update in progress
+const configRolloutCheckInterval = 15 * time.Second
+
+// Suffix of the versioned ConfigMaps, the configuration version is a hash of 16 hexadecimal digits
+var configVersionSuffixRegex = regexp.MustCompile(`-[0-9a-f]{16}$`)
+
+/************************************************
+ * Rolling Config Update
+ *************************************************/
+// Sets the configuration version mounted by the pods and returns whether its ConfigMaps must be staged.
+// The previous version is kept, without staging, while the desired version is the one rolled back.
+// Before the first versioned rollout, the previous version is the unversioned configuration.
+func (r *OrdsSrvsReconciler) ConfigVersionDefine(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) bool {
+	logr := log.FromContext(ctx).WithName("ConfigVersionDefine")
+
+	r.configVersion = ""
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		return true
+	}
+
+	desiredVersion := r.desiredConfigVersion(ctx, ordssrvs)
+	rollout := ordssrvs.Status.ConfigRollout
+	if rollout != nil && desiredVersion == rollout.FailedVersion &&
+		(rollout.CurrentVersion != "" || r.unversionedConfigExists(ctx, ordssrvs)) {
+		logr.Info("Configuration version " + desiredVersion + " was rolled back, keeping version " + configVersionName(rollout.CurrentVersion))
+		r.configVersion = rollout.CurrentVersion
+		return false
+	}
+	r.configVersion = desiredVersion
+	return true
+}
+
+// Returns the hash of the Global and Pool ConfigMaps data
+func (r *OrdsSrvsReconciler) desiredConfigVersion(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) string {
+	var configData []map[string]string
+	if globalConfigMap := r.ConfigMapDefine(ctx, ordssrvs, r.ordssrvsGlobalSettingsConfigMapName, 0); globalConfigMap != nil {
+		configData = append(configData, globalConfigMap.Data)
+	}
+	for i := 0; i < len(ordssrvs.Spec.PoolSettings); i++ {
+		poolName := strings.ToLower(ordssrvs.Spec.PoolSettings[i].PoolName)
+		if poolConfigMap := r.ConfigMapDefine(ctx, ordssrvs, ordssrvs.Name+"-cfg-pool-"+poolName, i); poolConfigMap != nil {
+			configData = append(configData, poolConfigMap.Data)
+		}
+	}
+	return generateSpecHash(configData)
+}
+
+// Appends the configuration version to the name of a Global or Pool ConfigMap
+func (r *OrdsSrvsReconciler) versionedConfigMapName(name string) string {
+	if r.configVersion == "" {
+		return name
+	}
+	return name + "-" + r.configVersion
+}
+
+// Returns whether a ConfigMap of the mounted configuration version exists
+func (r *OrdsSrvsReconciler) configMapExists(ctx context.Context, ordssrvs *dbapi.OrdsSrvs, configMapName string) bool {
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		return true
+	}
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: configMapName, Namespace: ordssrvs.Namespace}, configMap)
+	return !apierrors.IsNotFound(err)
+}
+
+// Returns whether the unversioned Global ConfigMap, mounted before rolling config updates were enabled, exists
+func (r *OrdsSrvsReconciler) unversionedConfigExists(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) bool {
+	configMap := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name + "-global-settings-config-map", Namespace: ordssrvs.Namespace}, configMap)
+	return err == nil
+}
+
+// Returns the configuration version as reported in the events, the configuration before the first versioned rollout is unversioned
+func configVersionName(version string) string {
+	if version == "" {
+		return "unversioned"
+	}
+	return version
+}
+
+// Returns whether the ConfigMap belongs to the configuration version running on all pods.
+// The unversioned ConfigMaps run until the first versioned rollout completes.
+func isRetainedConfigMap(ordssrvs *dbapi.OrdsSrvs, configMapName string) bool {
+	rollout := ordssrvs.Status.ConfigRollout
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		return false
+	}
+	if rollout == nil || rollout.CurrentVersion == "" {
+		return !configVersionSuffixRegex.MatchString(configMapName)
+	}
+	return strings.HasSuffix(configMapName, "-"+rollout.CurrentVersion)
+}
+
+// Replaces the pods one at a time, a new pod is started before an old pod is stopped
+func rollingUpdateDefine(ordssrvs *dbapi.OrdsSrvs, workload client.Object) {
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		return
+	}
+	maxSurge := intstr.FromInt32(1)
+	maxUnavailable := intstr.FromInt32(0)
+	switch workload := workload.(type) {
+	case *appsv1.Deployment:
+		progressDeadlineSeconds := ordssrvs.Spec.RollingConfigUpdate.ProgressDeadlineSeconds
+		workload.Spec.ProgressDeadlineSeconds = &progressDeadlineSeconds
+		workload.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+	case *appsv1.DaemonSet:
+		workload.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+			Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+			},
+		}
+	}
+	// StatefulSets replace their pods one at a time, waiting for the readiness of each pod
+}
+
+// Gates the rollout on the ORDS HTTP endpoint
+func readinessProbeDefine(ordssrvs *dbapi.OrdsSrvs) *corev1.Probe {
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		return nil
+	}
+	healthPath := ordssrvs.Spec.RollingConfigUpdate.HealthPath
+	if healthPath == "" {
+		healthPath = "/_/landing"
+	}
+	return &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: strings.TrimSuffix(ordssrvs.Spec.GlobalSettings.StandaloneContextPath, "/") + healthPath,
+				Port: intstr.FromString(targetHTTPPortName),
+			},
+		},
+		InitialDelaySeconds: 10,
+		PeriodSeconds:       10,
+		FailureThreshold:    3,
+	}
+}
+
+// Tracks the rollout of the configuration version and restores the previous version
+// when the new pods are not ready within progressDeadlineSeconds
+func (r *OrdsSrvsReconciler) ConfigRolloutReconcile(ctx context.Context, req ctrl.Request, ordssrvs *dbapi.OrdsSrvs) (requeueAfter time.Duration, err error) {
+	logr := log.FromContext(ctx).WithName("ConfigRolloutReconcile")
+
+	if err := r.Get(ctx, req.NamespacedName, ordssrvs); err != nil {
+		return 0, err
+	}
+
+	if ordssrvs.Spec.RollingConfigUpdate == nil {
+		if ordssrvs.Status.ConfigRollout == nil {
+			return 0, nil
+		}
+		ordssrvs.Status.ConfigRollout = nil
+		if err := r.Status().Update(ctx, ordssrvs); err != nil {
+			logr.Error(err, "Failed to update Status")
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	rollout := &dbapi.OrdsSrvsConfigRolloutStatus{}
+	if ordssrvs.Status.ConfigRollout != nil {
+		rollout = ordssrvs.Status.ConfigRollout.DeepCopy()
+	}
+	var condition *metav1.Condition
+
+	switch r.configVersion {
+	case rollout.CurrentVersion:
+		// previous version restored or nothing to roll out
+		rollout.TargetVersion = ""
+		rollout.StartTime = nil
+	case rollout.TargetVersion:
+		// rollout in progress
+	default:
+		now := metav1.Now()
+		rollout.TargetVersion = r.configVersion
+		rollout.StartTime = &now
+		logr.Info("Rolling out configuration version " + r.configVersion)
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "RollingUpdate", "Rolling out configuration version %s", r.configVersion)
+		condition = &metav1.Condition{Type: typeUnsyncedORDS, Status: metav1.ConditionTrue, Reason: "RollingUpdate",
+			Message: "Rolling out configuration version " + r.configVersion}
+	}
+
+	if rollout.TargetVersion != "" {
+		rolledOut, err := r.workloadRolledOut(ctx, ordssrvs)
+		if err != nil {
+			return 0, err
+		}
+		deadline := time.Duration(ordssrvs.Spec.RollingConfigUpdate.ProgressDeadlineSeconds) * time.Second
+		switch {
+		case rolledOut:
+			logr.Info("Rolled out configuration version " + rollout.TargetVersion)
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "RollingUpdate", "Rolled out configuration version %s", rollout.TargetVersion)
+			condition = &metav1.Condition{Type: typeUnsyncedORDS, Status: metav1.ConditionFalse, Reason: "RolledOut",
+				Message: "Configuration version " + rollout.TargetVersion + " rolled out"}
+			rollout.CurrentVersion = rollout.TargetVersion
+			rollout.TargetVersion = ""
+			rollout.StartTime = nil
+			rollout.FailedVersion = ""
+		case rollout.StartTime != nil && time.Since(rollout.StartTime.Time) > deadline:
+			if rollout.CurrentVersion == "" && !r.unversionedConfigExists(ctx, ordssrvs) {
+				// no previous version to restore
+				if rollout.FailedVersion != rollout.TargetVersion {
+					r.Recorder.Eventf(ordssrvs, corev1.EventTypeWarning, "RollingUpdate",
+						"Configuration version %s not ready after %s", rollout.TargetVersion, deadline)
+					rollout.FailedVersion = rollout.TargetVersion
+				}
+				requeueAfter = configRolloutCheckInterval
+				break
+			}
+			logr.Info("Rolling back configuration version " + rollout.TargetVersion + " to " + configVersionName(rollout.CurrentVersion))
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeWarning, "Rollback",
+				"Configuration version %s not ready after %s, rolling back to %s", rollout.TargetVersion, deadline, configVersionName(rollout.CurrentVersion))
+			condition = &metav1.Condition{Type: typeUnsyncedORDS, Status: metav1.ConditionTrue, Reason: "RolledBack",
+				Message: "Configuration version " + rollout.TargetVersion + " rolled back to " + configVersionName(rollout.CurrentVersion)}
+			rollout.FailedVersion = rollout.TargetVersion
+			rollout.TargetVersion = ""
+			rollout.StartTime = nil
+			requeueAfter = time.Second
+		default:
+			requeueAfter = configRolloutCheckInterval
+		}
+	}
+
+	if equality.Semantic.DeepEqual(ordssrvs.Status.ConfigRollout, rollout) && condition == nil {
+		return requeueAfter, nil
+	}
+	ordssrvs.Status.ConfigRollout = rollout
+	if condition != nil {
+		meta.SetStatusCondition(&ordssrvs.Status.Conditions, *condition)
+	}
+	if err := r.Status().Update(ctx, ordssrvs); err != nil {
+		logr.Error(err, "Failed to update Status")
+		return 0, err
+	}
+	return requeueAfter, nil
+}
+
+// Returns whether all pods of the workload run the current template and are ready
+func (r *OrdsSrvsReconciler) workloadRolledOut(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (bool, error) {
+	key := types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}
+	switch ordssrvs.Spec.WorkloadType {
+	//nolint:goconst
+	case "StatefulSet":
+		workload := &appsv1.StatefulSet{}
+		if err := r.Get(ctx, key, workload); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		replicas := int32(1)
+		if workload.Spec.Replicas != nil {
+			replicas = *workload.Spec.Replicas
+		}
+		return workload.Status.ObservedGeneration >= workload.Generation &&
+			workload.Status.CurrentRevision == workload.Status.UpdateRevision &&
+			workload.Status.UpdatedReplicas == replicas &&
+			workload.Status.ReadyReplicas == replicas, nil
+	//nolint:goconst
+	case "DaemonSet":
+		workload := &appsv1.DaemonSet{}
+		if err := r.Get(ctx, key, workload); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		return workload.Status.ObservedGeneration >= workload.Generation &&
+			workload.Status.UpdatedNumberScheduled == workload.Status.DesiredNumberScheduled &&
+			workload.Status.NumberReady == workload.Status.DesiredNumberScheduled, nil
+	default:
+		workload := &appsv1.Deployment{}
+		if err := r.Get(ctx, key, workload); err != nil {
+			return false, client.IgnoreNotFound(err)
+		}
+		replicas := int32(1)
+		if workload.Spec.Replicas != nil {
+			replicas = *workload.Spec.Replicas
+		}
+		return workload.Status.ObservedGeneration >= workload.Generation &&
+			workload.Status.UpdatedReplicas == replicas &&
+			workload.Status.Replicas == replicas &&
+			workload.Status.AvailableReplicas == replicas, nil
+	}
+}
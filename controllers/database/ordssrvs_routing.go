@@ -0,0 +1,337 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// The Gateway API is optional in the cluster, its resources are managed unstructured
+var (
+	httpRouteGVK = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "HTTPRoute"}
+	gatewayGVK   = schema.GroupVersionKind{Group: "gateway.networking.k8s.io", Version: "v1", Kind: "Gateway"}
+)
+
+/************************************************
+ * Ingress
+ *************************************************/
+func (r *OrdsSrvsReconciler) IngressReconcile(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("IngressReconcile")
+
+	definedIngress := &networkingv1.Ingress{}
+	if err = r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}, definedIngress); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		definedIngress = nil
+	}
+
+	if ordssrvs.Spec.Ingress == nil {
+		if definedIngress != nil && metav1.IsControlledBy(definedIngress, ordssrvs) {
+			if err := r.Delete(ctx, definedIngress); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			logr.Info("Deleted: Ingress")
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Delete", "Ingress %s Deleted", ordssrvs.Name)
+		}
+		return nil
+	}
+
+	desiredIngress := r.IngressDefine(ctx, ordssrvs)
+	if err := ctrl.SetControllerReference(ordssrvs, desiredIngress, r.Scheme); err != nil {
+		return err
+	}
+
+	if definedIngress == nil {
+		if err := r.Create(ctx, desiredIngress); err != nil {
+			return err
+		}
+		logr.Info("Created: Ingress")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Create", "Ingress %s Created", ordssrvs.Name)
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(definedIngress.Spec, desiredIngress.Spec) ||
+		!equality.Semantic.DeepEqual(definedIngress.Annotations, desiredIngress.Annotations) {
+		definedIngress.Spec = desiredIngress.Spec
+		definedIngress.Annotations = desiredIngress.Annotations
+		if err := r.Update(ctx, definedIngress); err != nil {
+			return err
+		}
+		logr.Info("Updated: Ingress")
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Update", "Ingress %s Updated", ordssrvs.Name)
+	}
+	return nil
+}
+
+func (r *OrdsSrvsReconciler) IngressDefine(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) *networkingv1.Ingress {
+	ingress := ordssrvs.Spec.Ingress
+	pathType := networkingv1.PathTypePrefix
+
+	def := &networkingv1.Ingress{
+		ObjectMeta: objectMetaDefine(ordssrvs, ordssrvs.Name),
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: ingress.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     ordssrvs.Spec.GlobalSettings.StandaloneContextPath,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: ordssrvs.Name,
+									Port: networkingv1.ServiceBackendPort{Name: serviceHTTPPortName},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	def.Annotations = ingress.Annotations
+	if ingress.IngressClassName != "" {
+		def.Spec.IngressClassName = &ingress.IngressClassName
+	}
+	if tlsSecretName := ingressTLSSecretName(ordssrvs); tlsSecretName != "" {
+		tls := networkingv1.IngressTLS{SecretName: tlsSecretName}
+		if ingress.Host != "" {
+			tls.Hosts = []string{ingress.Host}
+		}
+		def.Spec.TLS = []networkingv1.IngressTLS{tls}
+	}
+	return def
+}
+
+// The certSecret can only terminate TLS at the Ingress with the keys of a kubernetes.io/tls Secret
+func ingressTLSSecretName(ordssrvs *dbapi.OrdsSrvs) string {
+	if ordssrvs.Spec.Ingress.TLSSecretName != "" {
+		return ordssrvs.Spec.Ingress.TLSSecretName
+	}
+	certSecret := ordssrvs.Spec.GlobalSettings.CertSecret
+	if certSecret != nil && certSecret.Certificate == corev1.TLSCertKey && certSecret.CertificateKey == corev1.TLSPrivateKeyKey {
+		return certSecret.SecretName
+	}
+	return ""
+}
+
+/************************************************
+ * HTTPRoute
+ *************************************************/
+func (r *OrdsSrvsReconciler) HTTPRouteReconcile(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("HTTPRouteReconcile")
+
+	route := &unstructured.Unstructured{}
+	route.SetGroupVersionKind(httpRouteGVK)
+
+	if ordssrvs.Spec.HTTPRoute == nil {
+		if err := r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}, route); err != nil {
+			// Nothing to delete, also when the Gateway API is not installed
+			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+				return nil
+			}
+			return err
+		}
+		if metav1.IsControlledBy(route, ordssrvs) {
+			if err := r.Delete(ctx, route); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			logr.Info("Deleted: HTTPRoute")
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Delete", "HTTPRoute %s Deleted", ordssrvs.Name)
+		}
+		return nil
+	}
+
+	route.SetName(ordssrvs.Name)
+	route.SetNamespace(ordssrvs.Namespace)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, route, func() error {
+		route.SetLabels(getLabels(ordssrvs.Name))
+		route.SetAnnotations(ordssrvs.Spec.HTTPRoute.Annotations)
+		if err := unstructured.SetNestedField(route.Object, httpRouteSpecDefine(ordssrvs), "spec"); err != nil {
+			return err
+		}
+		return ctrl.SetControllerReference(ordssrvs, route, r.Scheme)
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			r.Recorder.Eventf(ordssrvs, corev1.EventTypeWarning, "HTTPRoute", "Gateway API is not installed in the cluster")
+		}
+		return err
+	}
+	if result != controllerutil.OperationResultNone {
+		logr.Info("HTTPRoute " + string(result))
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "HTTPRoute", "HTTPRoute %s %s", ordssrvs.Name, result)
+	}
+	return nil
+}
+
+func httpRouteSpecDefine(ordssrvs *dbapi.OrdsSrvs) map[string]interface{} {
+	var parentRefs []interface{}
+	for _, ref := range ordssrvs.Spec.HTTPRoute.ParentRefs {
+		parentRef := map[string]interface{}{
+			"group": gatewayGVK.Group,
+			"kind":  gatewayGVK.Kind,
+			"name":  ref.Name,
+		}
+		if ref.Namespace != "" {
+			parentRef["namespace"] = ref.Namespace
+		}
+		if ref.SectionName != "" {
+			parentRef["sectionName"] = ref.SectionName
+		}
+		parentRefs = append(parentRefs, parentRef)
+	}
+
+	spec := map[string]interface{}{
+		"parentRefs": parentRefs,
+		"rules": []interface{}{
+			map[string]interface{}{
+				"matches": []interface{}{
+					map[string]interface{}{
+						"path": map[string]interface{}{
+							"type":  "PathPrefix",
+							"value": ordssrvs.Spec.GlobalSettings.StandaloneContextPath,
+						},
+					},
+				},
+				"backendRefs": []interface{}{
+					map[string]interface{}{
+						"name": ordssrvs.Name,
+						"port": int64(*ordssrvs.Spec.GlobalSettings.StandaloneHTTPPort),
+					},
+				},
+			},
+		},
+	}
+	if len(ordssrvs.Spec.HTTPRoute.Hostnames) > 0 {
+		var hostnames []interface{}
+		for _, hostname := range ordssrvs.Spec.HTTPRoute.Hostnames {
+			hostnames = append(hostnames, hostname)
+		}
+		spec["hostnames"] = hostnames
+	}
+	return spec
+}
+
+/************************************************
+ * External URL
+ *************************************************/
+// Returns the URL of ORDS through the Ingress or HTTPRoute, empty until a host or address is known
+func (r *OrdsSrvsReconciler) ExternalURLDefine(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) string {
+	logr := log.FromContext(ctx).WithName("ExternalURLDefine")
+
+	scheme := "http"
+	host := ""
+	switch {
+	case ordssrvs.Spec.Ingress != nil:
+		if ingressTLSSecretName(ordssrvs) != "" {
+			scheme = "https"
+		}
+		host = ordssrvs.Spec.Ingress.Host
+		if host == "" {
+			ingress := &networkingv1.Ingress{}
+			if err := r.Get(ctx, types.NamespacedName{Name: ordssrvs.Name, Namespace: ordssrvs.Namespace}, ingress); err != nil {
+				logr.Info("Ingress not ready")
+				return ""
+			}
+			if lbs := ingress.Status.LoadBalancer.Ingress; len(lbs) > 0 {
+				if host = lbs[0].Hostname; host == "" {
+					host = lbs[0].IP
+				}
+			}
+		}
+	case ordssrvs.Spec.HTTPRoute != nil:
+		if len(ordssrvs.Spec.HTTPRoute.Hostnames) > 0 {
+			host = ordssrvs.Spec.HTTPRoute.Hostnames[0]
+		}
+		// Scheme and address from the listener of the first Gateway
+		ref := ordssrvs.Spec.HTTPRoute.ParentRefs[0]
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = ordssrvs.Namespace
+		}
+		gateway := &unstructured.Unstructured{}
+		gateway.SetGroupVersionKind(gatewayGVK)
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, gateway); err != nil {
+			logr.Info("Gateway not found", "Gateway", ref.Name)
+		} else {
+			listeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+			for _, l := range listeners {
+				listener, ok := l.(map[string]interface{})
+				if !ok || (ref.SectionName != "" && listener["name"] != ref.SectionName) {
+					continue
+				}
+				if listener["protocol"] == "HTTPS" {
+					scheme = "https"
+					break
+				}
+			}
+			if host == "" {
+				addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+				if len(addresses) > 0 {
+					if address, ok := addresses[0].(map[string]interface{}); ok {
+						host, _, _ = unstructured.NestedString(address, "value")
+					}
+				}
+			}
+		}
+	default:
+		return ""
+	}
+
+	if host == "" {
+		return ""
+	}
+	return scheme + "://" + host + ordssrvs.Spec.GlobalSettings.StandaloneContextPath
+}
@@ -0,0 +1,270 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	"github.com/oracle/oracle-database-operator/commons/hashicorp"
+	"github.com/oracle/oracle-database-operator/commons/k8s"
+	"github.com/oracle/oracle-database-operator/commons/oci"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Secrets holding the pool passwords read from OCI Vault or HashiCorp Vault
+const (
+	vaultSecretLabel               = "oracle.com/ords-operator-vault-secret"
+	vaultKeyDBPassword             = "dbpassword"
+	vaultKeyDBAdminUserPassword    = "dbadminuserpassword"
+	vaultKeyDBCDBAdminUserPassword = "dbcdbadminuserpassword"
+)
+
+// Audience and lifetime of the ServiceAccount tokens issued for the Kubernetes auth method of HashiCorp Vault
+const (
+	vaultTokenAudience                = "vault"
+	vaultTokenExpirationSeconds int64 = 600
+)
+
+// typeVaultSyncedORDS represents the status of the synchronization of the Vault secrets
+const typeVaultSyncedORDS = "VaultSynced"
+
+func vaultSecretName(ordssrvs *dbapi.OrdsSrvs, poolName string) string {
+	return ordssrvs.Name + "-vault-" + strings.ToLower(poolName)
+}
+
+// Returns the interval between synchronizations of the Vault secrets, 0 when no pool uses them
+func vaultSyncInterval(ordssrvs *dbapi.OrdsSrvs) time.Duration {
+	for _, pool := range ordssrvs.Spec.PoolSettings {
+		if pool.VaultSecrets != nil {
+			if ordssrvs.Spec.Vault == nil || ordssrvs.Spec.Vault.SyncInterval == 0 {
+				return 300 * time.Second
+			}
+			return time.Duration(ordssrvs.Spec.Vault.SyncInterval) * time.Second
+		}
+	}
+	return 0
+}
+
+/************************************************
+ * Vault Secrets
+ *************************************************/
+func (r *OrdsSrvsReconciler) VaultSecretsReconcile(ctx context.Context, ordssrvs *dbapi.OrdsSrvs) (err error) {
+	logr := log.FromContext(ctx).WithName("VaultSecretsReconcile")
+
+	// Vault clients are created on first use
+	var ociVault oci.VaultService
+	var hashicorpVault hashicorp.VaultService
+	readVaultSecret := func(vaultSecret *dbapi.VaultSecret) (string, error) {
+		if vaultSecret.OCISecretOCID != "" {
+			if ociVault == nil {
+				vault := dbapi.OrdsSrvsVault{}
+				if ordssrvs.Spec.Vault != nil {
+					vault = *ordssrvs.Spec.Vault
+				}
+				provider, err := oci.GetOciProvider(r.Client, oci.ApiKeyAuth{
+					ConfigMapName: vault.OciConfig.ConfigMapName,
+					SecretName:    vault.OciConfig.SecretName,
+					Namespace:     ordssrvs.Namespace,
+				})
+				if err != nil {
+					return "", err
+				}
+				if ociVault, err = oci.NewVaultService(logr, provider); err != nil {
+					return "", err
+				}
+			}
+			return ociVault.GetSecretValue(vaultSecret.OCISecretOCID)
+		}
+
+		if hashicorpVault == nil {
+			if ordssrvs.Spec.Vault == nil || ordssrvs.Spec.Vault.HashiCorp == nil {
+				return "", fmt.Errorf("vault.hashicorp is required to read %s", vaultSecret.HashiCorpPath)
+			}
+			config := ordssrvs.Spec.Vault.HashiCorp
+			token := ""
+			if config.TokenSecret != nil {
+				if token, err = k8s.GetSecretValue(r.Client, ordssrvs.Namespace, config.TokenSecret.SecretName, config.TokenSecret.PasswordKey); err != nil {
+					return "", err
+				}
+			}
+			jwt := ""
+			if config.KubernetesAuthRole != "" {
+				if jwt, err = r.vaultServiceAccountToken(ctx, ordssrvs.Namespace, config.KubernetesAuthServiceAccount); err != nil {
+					return "", err
+				}
+			}
+			caCert := ""
+			if config.CASecret != nil {
+				if caCert, err = k8s.GetSecretValue(r.Client, ordssrvs.Namespace, config.CASecret.SecretName, config.CASecret.CertificateKey); err != nil {
+					return "", err
+				}
+			}
+			if hashicorpVault, err = hashicorp.NewVaultService(logr, hashicorp.VaultConfig{
+				Address:             config.Address,
+				Mount:               config.Mount,
+				Namespace:           config.Namespace,
+				CACert:              []byte(caCert),
+				Token:               strings.TrimSpace(token),
+				KubernetesAuthRole:  config.KubernetesAuthRole,
+				KubernetesAuthMount: config.KubernetesAuthMount,
+				KubernetesAuthJWT:   jwt,
+			}); err != nil {
+				return "", err
+			}
+		}
+		return hashicorpVault.GetSecretValue(vaultSecret.HashiCorpPath, vaultSecret.HashiCorpKey)
+	}
+
+	definedSecrets := make(map[string]bool)
+	for _, pool := range ordssrvs.Spec.PoolSettings {
+		if pool.VaultSecrets == nil {
+			continue
+		}
+		secretName := vaultSecretName(ordssrvs, pool.PoolName)
+		definedSecrets[secretName] = true
+
+		data := make(map[string][]byte)
+		for key, vaultSecret := range map[string]*dbapi.VaultSecret{
+			vaultKeyDBPassword:             pool.VaultSecrets.DBPassword,
+			vaultKeyDBAdminUserPassword:    pool.VaultSecrets.DBAdminUserPassword,
+			vaultKeyDBCDBAdminUserPassword: pool.VaultSecrets.DBCDBAdminUserPassword,
+		} {
+			if vaultSecret == nil {
+				continue
+			}
+			value, err := readVaultSecret(vaultSecret)
+			if err != nil {
+				return fmt.Errorf("unable to read the %s Vault secret of pool %s: %w", key, pool.PoolName, err)
+			}
+			data[key] = []byte(value)
+		}
+
+		if err := r.vaultSecretCreateOrUpdate(ctx, ordssrvs, secretName, data); err != nil {
+			return err
+		}
+	}
+
+	// Delete the secrets of pools no longer using Vault
+	secretList := &corev1.SecretList{}
+	if err := r.List(ctx, secretList, client.InNamespace(ordssrvs.Namespace),
+		client.MatchingLabels(map[string]string{vaultSecretLabel: ordssrvs.Name})); err != nil {
+		return err
+	}
+	for i := range secretList.Items {
+		if definedSecrets[secretList.Items[i].Name] {
+			continue
+		}
+		if err := r.Delete(ctx, &secretList.Items[i]); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		logr.Info("Deleted: " + secretList.Items[i].Name)
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Delete", "Secret %s Deleted", secretList.Items[i].Name)
+	}
+	return nil
+}
+
+// A changed password restarts the pods, as for a ConfigMap change
+func (r *OrdsSrvsReconciler) vaultSecretCreateOrUpdate(ctx context.Context, ordssrvs *dbapi.OrdsSrvs, secretName string, data map[string][]byte) error {
+	logr := log.FromContext(ctx).WithName("vaultSecretCreateOrUpdate")
+
+	desiredSecret := &corev1.Secret{
+		ObjectMeta: objectMetaDefine(ordssrvs, secretName),
+		Type:       corev1.SecretTypeOpaque,
+		Data:       data,
+	}
+	desiredSecret.Labels[vaultSecretLabel] = ordssrvs.Name
+	if err := ctrl.SetControllerReference(ordssrvs, desiredSecret, r.Scheme); err != nil {
+		return err
+	}
+
+	definedSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: secretName, Namespace: ordssrvs.Namespace}, definedSecret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if err := r.Create(ctx, desiredSecret); err != nil {
+			return err
+		}
+		logr.Info("Created: " + secretName)
+		r.RestartPods = true
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Create", "Secret %s Created from Vault", secretName)
+		return nil
+	}
+
+	if !equality.Semantic.DeepEqual(definedSecret.Data, desiredSecret.Data) {
+		definedSecret.Data = desiredSecret.Data
+		if err := r.Update(ctx, definedSecret); err != nil {
+			return err
+		}
+		logr.Info("Updated: " + secretName)
+		r.RestartPods = true
+		r.Recorder.Eventf(ordssrvs, corev1.EventTypeNormal, "Update", "Secret %s Updated from Vault", secretName)
+	}
+	return nil
+}
+
+// Issues a short-lived token of a ServiceAccount of the OrdsSrvs namespace, never the token of the operator
+func (r *OrdsSrvsReconciler) vaultServiceAccountToken(ctx context.Context, namespace string, serviceAccountName string) (string, error) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: serviceAccountName, Namespace: namespace},
+	}
+	expirationSeconds := vaultTokenExpirationSeconds
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{vaultTokenAudience},
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	if err := r.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return "", fmt.Errorf("failed to request a token for ServiceAccount %s: %w", serviceAccountName, err)
+	}
+	return tokenRequest.Status.Token, nil
+}
@@ -42,6 +42,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -64,9 +65,13 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
 // SingleInstanceDatabaseReconciler reconciles a SingleInstanceDatabase object
@@ -98,6 +103,10 @@ var ErrAdminPasswordSecretNotFound error = errors.New("Admin password secret for
 //+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases/finalizers,verbs=update
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabasebackups,verbs=get;list;watch
+//+kubebuilder:rbac:groups=database.oracle.com,resources=dataguardbrokers,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=pods;pods/log;pods/exec;persistentvolumeclaims;services,verbs=create;delete;get;list;patch;update;watch
 //+kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
@@ -176,6 +185,15 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 		return result, nil
 	}
 
+	// Maintenance shuts the database down and holds back the creation of its pods
+	if singleInstanceDatabase.Spec.Maintenance {
+		result, err = r.manageMaintenance(singleInstanceDatabase, ctx, req)
+		if result.Requeue {
+			r.Log.Info("Reconcile queued")
+		}
+		return result, err
+	}
+
 	// PVC Creation for Datafiles Volume
 	result, err = r.createOrReplacePVCforDatafilesVol(ctx, req, singleInstanceDatabase)
 	if result.Requeue {
@@ -190,6 +208,20 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 		return result, nil
 	}
 
+	// Request the TCPS certificate from cert-manager
+	result, err = r.manageTcpsCertificate(singleInstanceDatabase, ctx, req)
+	if result.Requeue {
+		r.Log.Info("Reconcile queued")
+		return result, nil
+	}
+
+	// Standby-first patching holds the pods of this database until the standbys are patched
+	result, err = r.manageRollingPatch(singleInstanceDatabase, ctx, req)
+	if result.Requeue {
+		r.Log.Info("Reconcile queued")
+		return result, nil
+	}
+
 	// POD creation
 	result, err = r.createOrReplacePods(singleInstanceDatabase, cloneFromDatabase, referredPrimaryDatabase, ctx, req)
 	if result.Requeue {
@@ -204,6 +236,19 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 		return result, nil
 	}
 
+	// Restore the database from its backup before the instance starts
+	if singleInstanceDatabase.Spec.CreateAs == "restore" &&
+		!meta.IsStatusConditionTrue(singleInstanceDatabase.Status.Conditions, dbcommons.DatabaseRestored) {
+		result, err = r.restoreDatabase(singleInstanceDatabase, ctx, req)
+		if result.Requeue {
+			r.Log.Info("Reconcile queued")
+			return result, nil
+		}
+		if err != nil {
+			return result, nil
+		}
+	}
+
 	// Validate readiness
 	result, readyPod, err := r.validateDBReadiness(singleInstanceDatabase, ctx, req)
 	if result.Requeue {
@@ -239,6 +284,13 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 			return result, nil
 		}
 
+		// Update free-form Init Parameters
+		result, err = r.updateParameters(singleInstanceDatabase, readyPod, ctx, req)
+		if result.Requeue {
+			r.Log.Info("Reconcile queued")
+			return result, nil
+		}
+
 		// Configure TCPS
 		result, err = r.configTcps(singleInstanceDatabase, readyPod, ctx, req)
 		if result.Requeue {
@@ -246,6 +298,20 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 			return result, nil
 		}
 
+		// Create or drop pluggable databases
+		result, err = r.managePdbs(singleInstanceDatabase, readyPod, ctx, req)
+		if result.Requeue {
+			r.Log.Info("Reconcile queued")
+			return result, nil
+		}
+
+		// Scale the read replica standby databases
+		result, err = r.manageReadReplicas(singleInstanceDatabase, ctx, req)
+		if result.Requeue {
+			r.Log.Info("Reconcile queued")
+			return result, nil
+		}
+
 	} else {
 		if singleInstanceDatabase.Status.DgBroker == nil {
 			err = SetupStandbyDatabase(r, singleInstanceDatabase, referredPrimaryDatabase, ctx, req)
@@ -283,8 +349,45 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 
 	}
 
+	// Drop the conversion requested by a dataguardoperation once the spec matches it
+	if value, ok := singleInstanceDatabase.Annotations[dbcommons.ConvertToSnapshotStandbyAnnotation]; ok {
+		if requested, err := strconv.ParseBool(value); err != nil || requested == singleInstanceDatabase.Spec.ConvertToSnapshotStandby {
+			sidb := singleInstanceDatabase.DeepCopy()
+			delete(sidb.Annotations, dbcommons.ConvertToSnapshotStandbyAnnotation)
+			if err := r.Patch(ctx, sidb, client.MergeFrom(singleInstanceDatabase)); err != nil {
+				return requeueY, err
+			}
+			delete(singleInstanceDatabase.Annotations, dbcommons.ConvertToSnapshotStandbyAnnotation)
+			singleInstanceDatabase.ResourceVersion = sidb.ResourceVersion
+		}
+	}
+
+	// Convert the snapshot standby back to a physical standby once its TTL expires, the spec is left unchanged
+	snapshotRevertAt := getSnapshotStandbyRevertTime(singleInstanceDatabase)
+	if snapshotStatus := singleInstanceDatabase.Status.SnapshotStandby; snapshotStatus != nil {
+		if snapshotStatus.TTLExpired && !snapshotStandbyRequested(singleInstanceDatabase) {
+			snapshotStatus.TTLExpired = false
+		}
+		if snapshotRevertAt != nil && snapshotStandbyTarget(singleInstanceDatabase) && !time.Now().Before(snapshotRevertAt.Time) {
+			r.Log.Info("Snapshot standby TTL expired, converting back to physical standby", "RevertAt", snapshotRevertAt.Time)
+			snapshotStatus.TTLExpired = true
+			if err := r.Status().Update(ctx, singleInstanceDatabase); err != nil {
+				return requeueY, err
+			}
+			r.Recorder.Eventf(singleInstanceDatabase, corev1.EventTypeNormal, "Snapshot Standby TTL Expired", "Converting %s back to physical standby", singleInstanceDatabase.Status.Sid)
+		} else {
+			snapshotStatus.RevertAt = snapshotRevertAt
+		}
+	}
+
+	// Retry the drop of the restore point left by the conversion back to physical standby
+	if !singleInstanceDatabase.Status.ConvertToSnapshotStandby && singleInstanceDatabase.Status.SnapshotStandby != nil &&
+		singleInstanceDatabase.Status.SnapshotStandby.RestorePoint != "" && singleInstanceDatabase.Status.SnapshotStandby.LastRevertedAt != nil {
+		dropSnapshotRestorePoint(r, singleInstanceDatabase, &readyPod, ctx, req)
+	}
+
 	// manage snapshot database creation
-	if singleInstanceDatabase.Spec.ConvertToSnapshotStandby != singleInstanceDatabase.Status.ConvertToSnapshotStandby {
+	if snapshotStandbyTarget(singleInstanceDatabase) != singleInstanceDatabase.Status.ConvertToSnapshotStandby {
 		result, err := r.manageConvPhysicalToSnapshot(ctx, req)
 		if err != nil {
 			return requeueN, err
@@ -323,6 +426,15 @@ func (r *SingleInstanceDatabaseReconciler) Reconcile(ctx context.Context, req ct
 	completed = true
 	r.Log.Info("Reconcile completed")
 
+	// Scheduling a reconcile for the snapshot standby TTL, if it expires before the cert renewal
+	if snapshotRevertAt != nil && snapshotStandbyTarget(singleInstanceDatabase) {
+		revertAfter := time.Until(snapshotRevertAt.Time) + time.Second
+		if futureRequeue == requeueN || revertAfter < futureRequeue.RequeueAfter {
+			r.Log.Info("Scheduling Reconcile for snapshot standby TTL", "Duration(Minutes)", revertAfter.Minutes())
+			return ctrl.Result{RequeueAfter: revertAfter}, nil
+		}
+	}
+
 	// Scheduling a reconcile for certificate renewal, if TCPS is enabled
 	if futureRequeue != requeueN {
 		r.Log.Info("Scheduling Reconcile for cert renewal", "Duration(Hours)", futureRequeue.RequeueAfter.Hours())
@@ -345,6 +457,8 @@ func (r *SingleInstanceDatabaseReconciler) updateReconcileStatus(m *dbapi.Single
 	// Always refresh status before a reconcile
 	defer r.Status().Update(ctx, m)
 
+	r.updateStatusConditions(m, *err, *blocked, *completed)
+
 	errMsg := func() string {
 		if *err != nil {
 			return (*err).Error()
@@ -397,6 +511,131 @@ func (r *SingleInstanceDatabaseReconciler) updateReconcileStatus(m *dbapi.Single
 	meta.SetStatusCondition(&m.Status.Conditions, condition)
 }
 
+// #############################################################################
+//
+//	Derive the Ready, Provisioning, Patching, Degraded and ConfigSyncing
+//	conditions from the database status and the reconcile outcome
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) updateStatusConditions(m *dbapi.SingleInstanceDatabase, err error, blocked bool, completed bool) {
+
+	setCondition := func(conditionType string, status bool, reason string, message string) {
+		condition := metav1.Condition{
+			Type:               conditionType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: m.GetGeneration(),
+			Reason:             reason,
+			Message:            message,
+		}
+		if status {
+			condition.Status = metav1.ConditionTrue
+		}
+		meta.SetStatusCondition(&m.Status.Conditions, condition)
+	}
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	// Ready
+	switch {
+	case m.Status.Status == dbcommons.StatusReady:
+		setCondition(dbcommons.ConditionReady, true, dbcommons.DatabaseHealthyReason, "database is open and accepting connections")
+	case m.Status.Status == dbcommons.StatusPending || m.Status.Status == dbcommons.StatusCreating:
+		setCondition(dbcommons.ConditionReady, false, dbcommons.DatabasePendingReason, "database is "+strings.ToLower(m.Status.Status))
+	default:
+		setCondition(dbcommons.ConditionReady, false, dbcommons.DatabaseNotReadyReason, "database status is "+m.Status.Status)
+	}
+
+	// Provisioning
+	if m.Status.DatafilesCreated != "true" {
+		setCondition(dbcommons.ConditionProvisioning, true, dbcommons.DatafilesCreatingReason, "database datafiles are being created")
+	} else {
+		setCondition(dbcommons.ConditionProvisioning, false, dbcommons.DatafilesCreatedReason, "database datafiles created")
+	}
+
+	// Patching
+	switch {
+	case m.Status.RollingPatch != nil:
+		setCondition(dbcommons.ConditionPatching, true, m.Status.RollingPatch.Phase, "rolling patch to "+m.Status.RollingPatch.Image+" in progress")
+	case m.Status.Status == dbcommons.StatusPatching ||
+		(m.Status.DatafilesCreated == "true" && m.Status.DatafilesPatched == "false" && strings.ToUpper(m.Status.Role) == "PRIMARY"):
+		setCondition(dbcommons.ConditionPatching, true, dbcommons.DatapatchPendingReason, "database software patched, datapatch pending")
+	default:
+		setCondition(dbcommons.ConditionPatching, false, dbcommons.DatafilesPatchedReason, "no patching in progress")
+	}
+
+	// Degraded
+	switch {
+	case m.Status.Status == dbcommons.StatusError:
+		setCondition(dbcommons.ConditionDegraded, true, dbcommons.DatabaseErrorReason, errMsg)
+	case blocked && !completed:
+		setCondition(dbcommons.ConditionDegraded, true, dbcommons.ReconcileBlockedDegradedReason, errMsg)
+	case m.Status.Status == dbcommons.StatusNotReady && m.Status.DatafilesCreated == "true":
+		setCondition(dbcommons.ConditionDegraded, true, dbcommons.DatabaseNotReadyReason, "database was created but is not healthy")
+	default:
+		setCondition(dbcommons.ConditionDegraded, false, dbcommons.AsExpectedReason, "")
+	}
+
+	// ConfigSyncing
+	if pending := pendingConfigChanges(m); len(pending) > 0 {
+		setCondition(dbcommons.ConditionConfigSyncing, true, dbcommons.ConfigUpdatingReason, "applying "+strings.Join(pending, ", "))
+	} else if m.Status.Status == dbcommons.StatusUpdating {
+		setCondition(dbcommons.ConditionConfigSyncing, true, dbcommons.ConfigUpdatingReason, "applying database configuration changes")
+	} else {
+		setCondition(dbcommons.ConditionConfigSyncing, false, dbcommons.ConfigInSyncReason, "database configuration matches the spec")
+	}
+}
+
+// Returns the database configuration fields whose spec value is not yet applied
+func pendingConfigChanges(m *dbapi.SingleInstanceDatabase) []string {
+	var pending []string
+	if m.Status.DatafilesCreated != "true" || strings.ToUpper(m.Status.Role) != "PRIMARY" {
+		return pending
+	}
+	differs := func(spec *bool, status string) bool {
+		value, err := strconv.ParseBool(status)
+		return spec != nil && err == nil && *spec != value
+	}
+	if differs(m.Spec.ArchiveLog, m.Status.ArchiveLog) {
+		pending = append(pending, "archiveLog")
+	}
+	if differs(m.Spec.FlashBack, m.Status.FlashBack) {
+		pending = append(pending, "flashBack")
+	}
+	if differs(m.Spec.ForceLogging, m.Status.ForceLogging) {
+		pending = append(pending, "forceLog")
+	}
+	if p := m.Spec.InitParams; p != nil &&
+		(p.SgaTarget != 0 && p.SgaTarget != m.Status.InitParams.SgaTarget ||
+			p.PgaAggregateTarget != 0 && p.PgaAggregateTarget != m.Status.InitParams.PgaAggregateTarget ||
+			p.CpuCount != 0 && p.CpuCount != m.Status.InitParams.CpuCount ||
+			p.Processes != 0 && p.Processes != m.Status.InitParams.Processes) {
+		pending = append(pending, "initParams")
+	}
+	applied := make(map[string]dbapi.SingleInstanceDatabaseParameterStatus)
+	for _, p := range m.Status.Parameters {
+		applied[p.Name] = p
+	}
+	for _, p := range m.Spec.Parameters {
+		if a, ok := applied[strings.ToLower(p.Name)]; !ok || a.Value != p.Value || a.Scope != p.Scope {
+			pending = append(pending, "parameters")
+			break
+		}
+	}
+	if len(m.Spec.Pdbs) != len(m.Status.Pdbs) {
+		pending = append(pending, "pdbs")
+	} else {
+		for i := range m.Spec.Pdbs {
+			if !strings.EqualFold(m.Spec.Pdbs[i].Name, m.Status.Pdbs[i].Name) {
+				pending = append(pending, "pdbs")
+				break
+			}
+		}
+	}
+	return pending
+}
+
 // #############################################################################
 //
 //	Validate the CRD specs
@@ -690,13 +929,13 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 			}, {
 				Name: "tls-secret-vol",
 				VolumeSource: func() corev1.VolumeSource {
-					if m.Spec.TcpsTlsSecret == "" {
+					if tcpsTlsSecretName(m) == "" {
 						return corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
 					}
-					/* tls-secret is specified */
+					/* tls-secret is specified or issued by cert-manager */
 					return corev1.VolumeSource{
 						Secret: &corev1.SecretVolumeSource{
-							SecretName: m.Spec.TcpsTlsSecret,
+							SecretName: tcpsTlsSecretName(m),
 							Optional:   func() *bool { i := true; return &i }(),
 							Items: []corev1.KeyToPath{
 								{
@@ -725,6 +964,20 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 						},
 					}
 				}(),
+			}, {
+				Name: "backup-vol",
+				VolumeSource: func() corev1.VolumeSource {
+					if m.Spec.Persistence.BackupVolumeClaimName == "" {
+						return corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}
+					}
+					/* Persistence.BackupVolumeClaimName is specified */
+					return corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: m.Spec.Persistence.BackupVolumeClaimName,
+							ReadOnly:  false,
+						},
+					}
+				}(),
 			}},
 			InitContainers: func() []corev1.Container {
 				initContainers := []corev1.Container{}
@@ -743,6 +996,21 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 						}},
 					})
 				}
+				if m.Spec.Persistence.BackupVolumeClaimName != "" && m.Spec.Persistence.SetWritePermissions != nil && *m.Spec.Persistence.SetWritePermissions {
+					initContainers = append(initContainers, corev1.Container{
+						Name:    "init-backup-permissions",
+						Image:   m.Spec.Image.PullFrom,
+						Command: []string{"/bin/sh", "-c", fmt.Sprintf("chown %d:%d %s || true", int(dbcommons.ORACLE_UID), int(dbcommons.ORACLE_GUID), dbcommons.BackupLocation)},
+						SecurityContext: &corev1.SecurityContext{
+							// User ID 0 means, root user
+							RunAsUser: func() *int64 { i := int64(0); return &i }(),
+						},
+						VolumeMounts: []corev1.VolumeMount{{
+							MountPath: dbcommons.BackupLocation,
+							Name:      "backup-vol",
+						}},
+					})
+				}
 				if m.Spec.Image.PrebuiltDB {
 					initContainers = append(initContainers, corev1.Container{
 						Name:    "init-prebuiltdb",
@@ -818,6 +1086,13 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 			Containers: []corev1.Container{{
 				Name:  m.Name,
 				Image: m.Spec.Image.PullFrom,
+				Command: func() []string {
+					if m.Spec.CreateAs == "restore" {
+						// Database files are restored by the operator before the instance starts
+						return []string{"/bin/bash", "-c", dbcommons.WaitForRestoreCMD}
+					}
+					return nil
+				}(),
 				SecurityContext: &corev1.SecurityContext{
 					Capabilities: &corev1.Capabilities{
 						// Allow priority elevation for DB processes
@@ -828,14 +1103,13 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 					PreStop: &corev1.LifecycleHandler{
 						Exec: &corev1.ExecAction{
 							Command: func() []string {
-								// For patching use cases shutdown immediate is needed especially for standby databases
-								shutdown_mode := "immediate"
 								if m.Spec.Edition == "express" || m.Spec.Edition == "free" {
 									// express/free do not support patching
 									// To terminate any zombie instances left over due to forced termination
-									shutdown_mode = "abort"
+									return []string{"/bin/sh", "-c", "/bin/echo -en 'shutdown abort;\n' | env ORACLE_SID=${ORACLE_SID^^} sqlplus -S / as sysdba"}
 								}
-								return []string{"/bin/sh", "-c", "/bin/echo -en 'shutdown " + shutdown_mode + ";\n' | env ORACLE_SID=${ORACLE_SID^^} sqlplus -S / as sysdba"}
+								// Node drains and patching need a clean shutdown, especially for standby databases
+								return []string{"/bin/sh", "-c", dbcommons.GracefulShutdownCMD}
 							}(),
 						},
 					},
@@ -894,7 +1168,7 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 							SubPath:   "oracle_pwd",
 						})
 					}
-					if m.Spec.TcpsTlsSecret != "" {
+					if tcpsTlsSecretName(m) != "" {
 						mounts = append(mounts, corev1.VolumeMount{
 							MountPath: dbcommons.TlsCertsLocation,
 							ReadOnly:  true,
@@ -927,6 +1201,12 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 							SubPath: "setup",
 						})
 					}
+					if m.Spec.Persistence.BackupVolumeClaimName != "" {
+						mounts = append(mounts, corev1.VolumeMount{
+							MountPath: dbcommons.BackupLocation,
+							Name:      "backup-vol",
+						})
+					}
 					return mounts
 				}(),
 				Env: func() []corev1.EnvVar {
@@ -1191,7 +1471,14 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 				}(),
 			}},
 
-			TerminationGracePeriodSeconds: func() *int64 { i := int64(30); return &i }(),
+			TerminationGracePeriodSeconds: func() *int64 {
+				i := int64(30)
+				if m.Spec.Edition != "express" && m.Spec.Edition != "free" {
+					// Leave the preStop hook enough time to shut the instance down
+					i = dbcommons.GracefulShutdownPeriodSeconds
+				}
+				return &i
+			}(),
 
 			NodeSelector: func() map[string]string {
 				ns := make(map[string]string)
@@ -1252,6 +1539,34 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 				append(pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, weightedPodAffinityTerm)
 		}
 
+		// Also prefer a zone other than the primary's, so that a zone outage leaves one of them running
+		zonePodAffinityTerm := weightedPodAffinityTerm
+		zonePodAffinityTerm.Weight = 50
+		zonePodAffinityTerm.PodAffinityTerm.TopologyKey = "topology.kubernetes.io/zone"
+		pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution =
+			append(pod.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, zonePodAffinityTerm)
+	}
+
+	// Read replica pods are selected by the read-only service of their primary
+	if primary, ok := m.Labels[dbcommons.ReadReplicaOfLabel]; ok {
+		pod.Labels[dbcommons.ReadReplicaOfLabel] = primary
+	}
+
+	// Scheduling constraints from the spec
+	pod.Spec.Affinity = mergeAffinity(pod.Spec.Affinity, m.Spec.Affinity)
+	pod.Spec.Tolerations = m.Spec.Tolerations
+	pod.Spec.PriorityClassName = m.Spec.PriorityClassName
+	pod.Spec.TopologySpreadConstraints = m.Spec.TopologySpreadConstraints
+	if primary, ok := m.Labels[dbcommons.ReadReplicaOfLabel]; ok && len(pod.Spec.TopologySpreadConstraints) == 0 {
+		// Spread the read replicas of a primary across zones
+		pod.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
+			MaxSkew:           1,
+			TopologyKey:       "topology.kubernetes.io/zone",
+			WhenUnsatisfiable: corev1.ScheduleAnyway,
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{dbcommons.ReadReplicaOfLabel: primary},
+			},
+		}}
 	}
 
 	// Set SingleInstanceDatabase instance as the owner and controller
@@ -1260,6 +1575,37 @@ func (r *SingleInstanceDatabaseReconciler) instantiatePodSpec(m *dbapi.SingleIns
 
 }
 
+// Merges the affinity of the spec into the affinity set by the operator.
+// The node affinity of the spec is used as is, pod (anti-)affinity terms are added to the operator's
+func mergeAffinity(affinity *corev1.Affinity, specAffinity *corev1.Affinity) *corev1.Affinity {
+	if specAffinity == nil {
+		return affinity
+	}
+	merged := specAffinity.DeepCopy()
+	if affinity == nil {
+		return merged
+	}
+	if affinity.PodAffinity != nil {
+		if merged.PodAffinity == nil {
+			merged.PodAffinity = &corev1.PodAffinity{}
+		}
+		merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(merged.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(merged.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if affinity.PodAntiAffinity != nil {
+		if merged.PodAntiAffinity == nil {
+			merged.PodAntiAffinity = &corev1.PodAntiAffinity{}
+		}
+		merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(merged.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = append(merged.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution,
+			affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	return merged
+}
+
 // #############################################################################
 //
 //	Instantiate Service spec from SingleInstanceDatabase spec
@@ -1517,7 +1863,7 @@ func (r *SingleInstanceDatabaseReconciler) createOrReplacePVCforDatafilesVol(ctx
 			}
 			pvcDeleted = true
 
-		} else if pvc.Spec.Resources.Requests["storage"] != resource.MustParse(m.Spec.Persistence.Size) {
+		} else if requestedSize := resource.MustParse(m.Spec.Persistence.Size); requestedSize.Cmp(pvc.Spec.Resources.Requests["storage"]) != 0 {
 			// check the storage class of the pvc
 			// if the storage class doesn't support resize the throw an error event and try expanding via deleting and recreating the pv and pods
 			if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
@@ -1553,10 +1899,31 @@ func (r *SingleInstanceDatabaseReconciler) createOrReplacePVCforDatafilesVol(ctx
 				log.Error(err, "Error while updating the PVCs")
 				return requeueY, fmt.Errorf("error while updating the PVCs")
 			}
+			// Wait for the volume and filesystem expansion
+			return requeueY, nil
 
 		} else {
 
 			log.Info("Found Existing PVC", "Name", pvc.Name)
+			if pvc.Status.Phase != corev1.ClaimBound {
+				return requeueN, nil
+			}
+			capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+			if capacity.Cmp(requestedSize) < 0 {
+				// Volume expanded online, the filesystem is resized by the kubelet on the node running the pod.
+				// Pods are not held back: a pending filesystem resize needs a pod mounting the volume, and a
+				// storage class that never completes the expansion must not block the database
+				for _, condition := range pvc.Status.Conditions {
+					if condition.Status == corev1.ConditionTrue && (condition.Type == corev1.PersistentVolumeClaimResizing ||
+						condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending) {
+						log.Info("PVC expansion in progress", "condition", condition.Type, "message", condition.Message)
+					}
+				}
+			}
+			if m.Status.DatafilesVolumeCapacity != "" && m.Status.DatafilesVolumeCapacity != capacity.String() {
+				r.Recorder.Eventf(m, corev1.EventTypeNormal, "PVC Expanded", "datafiles volume expanded to %s", capacity.String())
+			}
+			m.Status.DatafilesVolumeCapacity = capacity.String()
 			return requeueN, nil
 
 		}
@@ -2328,6 +2695,57 @@ func (r *SingleInstanceDatabaseReconciler) deletePods(ctx context.Context, req c
 	return requeueN, nil
 }
 
+// #############################################################################
+//
+//	Shut the database down and delete its pods for maintenance
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) manageMaintenance(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("manageMaintenance", req.NamespacedName)
+
+	readyPod, _, available, _, err := dbcommons.FindPods(r, "", "", m.Name, m.Namespace, ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	if readyPod.Name != "" {
+		// Shut the instance down before the pods are removed, so that they can be deleted without a grace period
+		log.Info("Shutting down the database for maintenance", "POD.NAME", readyPod.Name)
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false,
+			"bash", "-c", dbcommons.GracefulShutdownCMD)
+		if err != nil {
+			log.Error(err, err.Error())
+			r.Recorder.Event(m, corev1.EventTypeWarning, "Shutdown Failed", err.Error())
+			return requeueY, err
+		}
+		log.Info("Shutdown Output")
+		log.Info(out)
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Database Shutdown", "database %s shut down for maintenance", m.Status.Sid)
+		result, err := r.deletePods(ctx, req, m, []corev1.Pod{readyPod}, corev1.Pod{}, 1, 0)
+		if result.Requeue {
+			return result, err
+		}
+	}
+
+	// Pods whose instance is not ready are deleted with their grace period, so that the preStop hook shuts the instance down
+	for i := range available {
+		if available[i].DeletionTimestamp != nil {
+			continue
+		}
+		log.Info("Deleting Pod : ", "POD.NAME", available[i].Name)
+		if err := r.Delete(ctx, &available[i]); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed to delete existing POD", "POD.Name", available[i].Name)
+			return requeueY, err
+		}
+		m.Status.Replicas -= 1
+	}
+
+	m.Status.Status = dbcommons.StatusMaintenance
+	return requeueN, nil
+}
+
 // #############################################################################
 //
 //	ValidateDBReadiness and return the ready POD
@@ -2529,12 +2947,24 @@ func (r *SingleInstanceDatabaseReconciler) updateClientWallet(m *dbapi.SingleIns
 func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDatabase,
 	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	eventReason := "Configuring TCPS"
+	tlsSecret := tcpsTlsSecretName(m)
 
 	if (m.Spec.EnableTCPS) &&
 		((!m.Status.IsTcpsEnabled) || // TCPS Enabled from a TCP state
-			(m.Spec.TcpsTlsSecret != "" && m.Status.TcpsTlsSecret == "") || // TCPS Secret is added in spec
-			(m.Spec.TcpsTlsSecret == "" && m.Status.TcpsTlsSecret != "") || // TCPS Secret is removed in spec
-			(m.Spec.TcpsTlsSecret != "" && m.Status.TcpsTlsSecret != "" && m.Spec.TcpsTlsSecret != m.Status.TcpsTlsSecret)) { //TCPS secret is changed
+			(tlsSecret != "" && m.Status.TcpsTlsSecret == "") || // TCPS Secret is added in spec
+			(tlsSecret == "" && m.Status.TcpsTlsSecret != "") || // TCPS Secret is removed in spec
+			(tlsSecret != "" && m.Status.TcpsTlsSecret != "" && tlsSecret != m.Status.TcpsTlsSecret)) { //TCPS secret is changed
+
+		// Wait for cert-manager to issue the certificate
+		certChecksum := ""
+		if m.Spec.TcpsCertIssuerRef != nil {
+			checksum, err := r.getTcpsCertChecksum(m, ctx)
+			if err != nil {
+				r.Log.Info("Waiting for cert-manager to issue the TCPS certificate", "secret", tlsSecret)
+				return requeueY, nil
+			}
+			certChecksum = checksum
+		}
 
 		// Set status to Updating, except when an error has been thrown from configTCPS script
 		if m.Status.Status != dbcommons.StatusError {
@@ -2546,7 +2976,7 @@ func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDat
 		r.Recorder.Eventf(m, corev1.EventTypeNormal, eventReason, eventMsg)
 
 		var TcpsCommand = dbcommons.EnableTcpsCMD
-		if m.Spec.TcpsTlsSecret != "" { // case when tls secret is either added or changed
+		if tlsSecret != "" { // case when tls secret is either added or changed
 			TcpsCommand = "export TCPS_CERTS_LOCATION=" + dbcommons.TlsCertsLocation + " && " + dbcommons.EnableTcpsCMD
 
 			// Checking for tls-secret mount in pods
@@ -2570,6 +3000,16 @@ func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDat
 			}
 		}
 
+		// Wait for the issued certificate to be mounted in the pod
+		if certChecksum != "" {
+			out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "",
+				ctx, req, false, "bash", "-c", dbcommons.TlsCertChecksumCMD)
+			if err != nil || strings.TrimSpace(out) != certChecksum {
+				r.Log.Info("Waiting for the TCPS certificate to be mounted in the pod", "pod", readyPod.Name)
+				return requeueY, nil
+			}
+		}
+
 		// Enable TCPS
 		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "",
 			ctx, req, false, "bash", "-c", TcpsCommand)
@@ -2586,13 +3026,10 @@ func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDat
 		m.Status.CertCreationTimestamp = time.Now().Format(time.RFC3339)
 		m.Status.IsTcpsEnabled = true
 		m.Status.ClientWalletLoc = fmt.Sprintf(dbcommons.ClientWalletLocation, m.Spec.Sid)
-		// m.Spec.TcpsTlsSecret can be empty or non-empty
+		// tlsSecret can be empty or non-empty
 		// Store secret name in case of tls-secret addition or change, otherwise would be ""
-		if m.Spec.TcpsTlsSecret != "" {
-			m.Status.TcpsTlsSecret = m.Spec.TcpsTlsSecret
-		} else {
-			m.Status.TcpsTlsSecret = ""
-		}
+		m.Status.TcpsTlsSecret = tlsSecret
+		m.Status.TcpsCertChecksum = certChecksum
 
 		r.Status().Update(ctx, m)
 
@@ -2629,6 +3066,7 @@ func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDat
 		m.Status.IsTcpsEnabled = false
 		m.Status.ClientWalletLoc = ""
 		m.Status.TcpsTlsSecret = ""
+		m.Status.TcpsCertChecksum = ""
 
 		r.Status().Update(ctx, m)
 
@@ -2675,6 +3113,9 @@ func (r *SingleInstanceDatabaseReconciler) configTcps(m *dbapi.SingleInstanceDat
 			r.Log.Error(err, "Error in updating tnsnames.ora clientWallet...")
 			return requeueY, nil
 		}
+	} else if m.Spec.EnableTCPS && m.Status.IsTcpsEnabled && m.Spec.TcpsCertIssuerRef != nil {
+		// Certificates are renewed by cert-manager, import a rotated certificate
+		return r.rotateTcpsCertificate(m, readyPod, ctx, req)
 	} else if m.Spec.EnableTCPS && m.Status.IsTcpsEnabled && m.Spec.TcpsCertRenewInterval == "" {
 		// update clientWallet
 		err := r.updateClientWallet(m, readyPod, ctx, req)
@@ -3236,6 +3677,10 @@ func (r *SingleInstanceDatabaseReconciler) manageConvPhysicalToSnapshot(ctx cont
 		log.Error(err, err.Error())
 		return requeueY, err
 	}
+	// The status update of an expired TTL may not be read back yet
+	if snapshotStandbyTarget(&singleInstanceDatabase) == singleInstanceDatabase.Status.ConvertToSnapshotStandby {
+		return requeueY, nil
+	}
 
 	sidbReadyPod, err := GetDatabaseReadyPod(r, &singleInstanceDatabase, ctx, req)
 	if err != nil {
@@ -3247,7 +3692,7 @@ func (r *SingleInstanceDatabaseReconciler) manageConvPhysicalToSnapshot(ctx cont
 		return requeueY, nil
 	}
 
-	if singleInstanceDatabase.Spec.ConvertToSnapshotStandby {
+	if snapshotStandbyTarget(&singleInstanceDatabase) {
 		// Convert a PHYSICAL_STANDBY -> SNAPSHOT_STANDBY
 		if singleInstanceDatabase.Status.Status != dbcommons.StatusPending {
 			singleInstanceDatabase.Status.Status = dbcommons.StatusUpdating
@@ -3282,6 +3727,12 @@ func (r *SingleInstanceDatabaseReconciler) manageConvPhysicalToSnapshot(ctx cont
 		}
 		log.Info(fmt.Sprintf("Database %s converted to snapshot standby", singleInstanceDatabase.Name))
 		singleInstanceDatabase.Status.ConvertToSnapshotStandby = true
+		if singleInstanceDatabase.Status.SnapshotStandby == nil {
+			singleInstanceDatabase.Status.SnapshotStandby = &dbapi.SingleInstanceDatabaseSnapshotStandbyStatus{}
+		}
+		convertedAt := metav1.Now()
+		singleInstanceDatabase.Status.SnapshotStandby.ConvertedAt = &convertedAt
+		singleInstanceDatabase.Status.SnapshotStandby.RevertAt = getSnapshotStandbyRevertTime(&singleInstanceDatabase)
 		singleInstanceDatabase.Status.Status = dbcommons.StatusReady
 		// Get database role and update the status
 		sidbRole, err := dbcommons.GetDatabaseRole(sidbReadyPod, r, r.Config, ctx, req)
@@ -3304,6 +3755,14 @@ func (r *SingleInstanceDatabaseReconciler) manageConvPhysicalToSnapshot(ctx cont
 		}
 		singleInstanceDatabase.Status.ConvertToSnapshotStandby = false
 		singleInstanceDatabase.Status.Status = dbcommons.StatusReady
+		if snapshotStatus := singleInstanceDatabase.Status.SnapshotStandby; snapshotStatus != nil {
+			revertedAt := metav1.Now()
+			snapshotStatus.LastRevertedAt = &revertedAt
+			snapshotStatus.ConvertedAt = nil
+			snapshotStatus.RevertAt = nil
+			r.Recorder.Eventf(&singleInstanceDatabase, corev1.EventTypeNormal, "Converted to Physical Standby",
+				"Re-applied %d archived logs (%d bytes) of redo received as snapshot standby", snapshotStatus.ReappliedArchivedLogs, snapshotStatus.ReappliedRedoBytes)
+		}
 		// Get database role and update the status
 		sidbRole, err := dbcommons.GetDatabaseRole(sidbReadyPod, r, r.Config, ctx, req)
 		if err != nil {
@@ -3359,6 +3818,26 @@ func convertPhysicalStdToSnapshotStdDB(r *SingleInstanceDatabaseReconciler, sing
 		return err
 	}
 
+	// Create the requested guaranteed restore point once, before the conversion
+	restorePoint := strings.ToUpper(singleInstanceDatabase.Spec.SnapshotRestorePoint)
+	if singleInstanceDatabase.Status.SnapshotStandby == nil {
+		singleInstanceDatabase.Status.SnapshotStandby = &dbapi.SingleInstanceDatabaseSnapshotStandbyStatus{}
+	}
+	if restorePoint != "" && singleInstanceDatabase.Status.SnapshotStandby.RestorePoint != restorePoint {
+		out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf("echo -e  \"%s\"  | %s", fmt.Sprintf(dbcommons.CreateSnapshotRestorePointSQL, restorePoint), dbcommons.SQLPlusCLI))
+		if err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("Create restore point output \n %s", out))
+		scn := regexp.MustCompile(`restore_point_scn:(\d+)`).FindStringSubmatch(out)
+		if scn == nil {
+			return fmt.Errorf("failed to create restore point %s", restorePoint)
+		}
+		singleInstanceDatabase.Status.SnapshotStandby.RestorePoint = restorePoint
+		singleInstanceDatabase.Status.SnapshotStandby.RestorePointScn = scn[1]
+	}
+
 	out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, true, "bash", "-c", fmt.Sprintf("dgmgrl sys@%s \"convert database %s to snapshot standby;\" < admin.pwd", dataguardBroker.Status.PrimaryDatabase, singleInstanceDatabase.Status.Sid))
 	if err != nil {
 		return err
@@ -3400,8 +3879,24 @@ func convertSnapshotStdToPhysicalStdDB(r *SingleInstanceDatabaseReconciler, sing
 	if err != nil {
 		return err
 	}
+	// Archived redo received since the conversion to snapshot standby is applied after the conversion back
+	out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e  \"%s\"  | %s", dbcommons.SnapshotStandbyRedoSQL, dbcommons.SQLPlusCLI))
+	if err != nil {
+		return err
+	}
+	if singleInstanceDatabase.Status.SnapshotStandby == nil {
+		singleInstanceDatabase.Status.SnapshotStandby = &dbapi.SingleInstanceDatabaseSnapshotStandbyStatus{}
+	}
+	singleInstanceDatabase.Status.SnapshotStandby.ReappliedArchivedLogs = 0
+	singleInstanceDatabase.Status.SnapshotStandby.ReappliedRedoBytes = 0
+	if redo := regexp.MustCompile(`snapshot_redo:(\d+):(\d+)`).FindStringSubmatch(out); redo != nil {
+		singleInstanceDatabase.Status.SnapshotStandby.ReappliedArchivedLogs, _ = strconv.Atoi(redo[1])
+		singleInstanceDatabase.Status.SnapshotStandby.ReappliedRedoBytes, _ = strconv.ParseInt(redo[2], 10, 64)
+	}
+
 	log.Info("Converting snapshot standby to physical standby")
-	out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, true, "bash", "-c", fmt.Sprintf("dgmgrl sys@%s \"convert database %s to physical standby;\" < admin.pwd", dataguardBroker.Status.PrimaryDatabase, singleInstanceDatabase.Status.Sid))
+	out, err = dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, true, "bash", "-c", fmt.Sprintf("dgmgrl sys@%s \"convert database %s to physical standby;\" < admin.pwd", dataguardBroker.Status.PrimaryDatabase, singleInstanceDatabase.Status.Sid))
 	if err != nil {
 		log.Error(err, err.Error())
 		return err
@@ -3415,9 +3910,67 @@ func convertSnapshotStdToPhysicalStdDB(r *SingleInstanceDatabaseReconciler, sing
 	}
 	log.Info(fmt.Sprintf("PDB open command output %s", out))
 
+	// Drop the restore point created before the conversion to snapshot standby
+	dropSnapshotRestorePoint(r, singleInstanceDatabase, sidbReadyPod, ctx, req)
+
 	return nil
 }
 
+// Drops the restore point created before the conversion to snapshot standby, it is kept in the status until the drop succeeds
+func dropSnapshotRestorePoint(r *SingleInstanceDatabaseReconciler, singleInstanceDatabase *dbapi.SingleInstanceDatabase, sidbReadyPod *corev1.Pod, ctx context.Context, req ctrl.Request) {
+	log := r.Log.WithValues("dropSnapshotRestorePoint", req.NamespacedName)
+
+	snapshotStatus := singleInstanceDatabase.Status.SnapshotStandby
+	if snapshotStatus == nil || snapshotStatus.RestorePoint == "" {
+		return
+	}
+	out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e  \"%s\"  | %s", fmt.Sprintf(dbcommons.DropSnapshotRestorePointSQL, snapshotStatus.RestorePoint), dbcommons.SQLPlusCLI))
+	// ORA-38780: the restore point was already dropped
+	if err == nil && strings.Contains(out, "ORA-") && !strings.Contains(out, "ORA-38780") {
+		err = errors.New(strings.TrimSpace(out))
+	}
+	if err != nil {
+		log.Error(err, "failed to drop restore point", "RestorePoint", snapshotStatus.RestorePoint)
+		r.Recorder.Eventf(singleInstanceDatabase, corev1.EventTypeWarning, "Restore Point Drop Failed", "Restore point %s not dropped: %s", snapshotStatus.RestorePoint, err.Error())
+		return
+	}
+	log.Info(fmt.Sprintf("Drop restore point output %s", out))
+	snapshotStatus.RestorePoint = ""
+	snapshotStatus.RestorePointScn = ""
+}
+
+// Returns whether the database is requested to be a snapshot standby, a conversion requested by a dataguardoperation takes precedence over the spec
+func snapshotStandbyRequested(m *dbapi.SingleInstanceDatabase) bool {
+	if value, ok := m.Annotations[dbcommons.ConvertToSnapshotStandbyAnnotation]; ok {
+		if requested, err := strconv.ParseBool(value); err == nil {
+			return requested
+		}
+	}
+	return m.Spec.ConvertToSnapshotStandby
+}
+
+// Returns whether the database should be a snapshot standby, it is not once converted back after its TTL
+func snapshotStandbyTarget(m *dbapi.SingleInstanceDatabase) bool {
+	if m.Status.SnapshotStandby != nil && m.Status.SnapshotStandby.TTLExpired {
+		return false
+	}
+	return snapshotStandbyRequested(m)
+}
+
+// Returns the time at which the snapshot standby is converted back to a physical standby, nil without a TTL
+func getSnapshotStandbyRevertTime(m *dbapi.SingleInstanceDatabase) *metav1.Time {
+	if m.Spec.SnapshotStandbyTTL == "" || m.Status.SnapshotStandby == nil || m.Status.SnapshotStandby.ConvertedAt == nil {
+		return nil
+	}
+	ttl, err := time.ParseDuration(m.Spec.SnapshotStandbyTTL)
+	if err != nil {
+		return nil
+	}
+	revertAt := metav1.NewTime(m.Status.SnapshotStandby.ConvertedAt.Add(ttl))
+	return &revertAt
+}
+
 // #############################################################################
 //
 //	SetupWithManager sets up the controller with the Manager
@@ -3425,13 +3978,27 @@ func convertSnapshotStdToPhysicalStdDB(r *SingleInstanceDatabaseReconciler, sing
 // #############################################################################
 func (r *SingleInstanceDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&dbapi.SingleInstanceDatabase{}).
-		Owns(&corev1.Pod{}). //Watch for deleted pods of SingleInstanceDatabase Owner
-		WithEventFilter(dbcommons.ResourceEventHandler()).
+		For(&dbapi.SingleInstanceDatabase{}, builder.WithPredicates(predicate.Or(dbcommons.ResourceEventHandler(), snapshotStandbyRequestPredicate()))).
+		Owns(&corev1.Pod{}, builder.WithPredicates(dbcommons.ResourceEventHandler())). //Watch for deleted pods of SingleInstanceDatabase Owner
+		// Watch for TCPS certificates rotated by cert-manager
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.tcpsCertSecretToDatabase), builder.WithPredicates(predicate.ResourceVersionChangedPredicate{})).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 100}). //ReconcileHandler is never invoked concurrently with the same object.
 		Complete(r)
 }
 
+// Reconcile when a dataguardoperation requests a snapshot standby conversion through the annotation
+func snapshotStandbyRequestPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetAnnotations()[dbcommons.ConvertToSnapshotStandbyAnnotation] !=
+				e.ObjectNew.GetAnnotations()[dbcommons.ConvertToSnapshotStandbyAnnotation]
+		},
+	}
+}
+
 // #############################################################################
 //
 //	Check primary database status
@@ -0,0 +1,235 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Parameter as currently set in the instance
+type instanceParameter struct {
+	modifiable string
+	value      string
+}
+
+// #############################################################################
+//
+//	Apply the free-form initialization parameters of spec.parameters.
+//	Static parameters with scope both are written to the spfile and the
+//	database is restarted once all parameters are set
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) updateParameters(m *dbapi.SingleInstanceDatabase,
+	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("updateParameters", req.NamespacedName)
+
+	if len(m.Spec.Parameters) == 0 && len(m.Status.Parameters) == 0 {
+		return requeueN, nil
+	}
+
+	current, startupTime, err := r.getInstanceParameters(m, readyPod, ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	applied := make(map[string]dbapi.SingleInstanceDatabaseParameterStatus)
+	for _, p := range m.Status.Parameters {
+		// spfile values are in effect once the instance has restarted
+		if startupTime != m.Status.InstanceStartupTime {
+			p.PendingRestart = false
+		}
+		applied[p.Name] = p
+	}
+
+	restart := false
+	wanted := make(map[string]bool)
+	for _, p := range m.Spec.Parameters {
+		name := strings.ToLower(p.Name)
+		wanted[name] = true
+		if a, ok := applied[name]; ok && a.Value == p.Value && a.Scope == p.Scope {
+			continue
+		}
+		param, found := current[name]
+		if !found {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Invalid init-param", "Unknown initialization parameter %s", name)
+			continue
+		}
+		static := param.modifiable == "FALSE"
+		if static && p.Scope == "memory" {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Invalid init-param",
+				"Initialization parameter %s is static and cannot be set with scope memory", name)
+			continue
+		}
+		scope := strings.ToUpper(p.Scope)
+		if static {
+			scope = "SPFILE"
+		}
+		deferred := ""
+		if param.modifiable == "DEFERRED" && scope != "SPFILE" {
+			deferred = " DEFERRED"
+		}
+
+		log.Info("Setting initialization parameter", "name", name, "value", p.Value, "scope", scope)
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.SQLPlusScriptCMD, fmt.Sprintf(dbcommons.AlterSystemSetSQL, name, p.Value, deferred, scope)))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		if strings.Contains(out, "ORA-") {
+			eventReason := "Invalid init-param value"
+			eventMsg := "Unable to change the init-param " + name + " as specified. Error log: \n" + out
+			r.Recorder.Event(m, corev1.EventTypeWarning, eventReason, eventMsg)
+			continue
+		}
+		applied[name] = dbapi.SingleInstanceDatabaseParameterStatus{
+			Name:           name,
+			Value:          p.Value,
+			Scope:          p.Scope,
+			PendingRestart: scope == "SPFILE",
+		}
+		restart = restart || (static && p.Scope == "both")
+	}
+
+	// Parameters removed from the spec fall back to their default at the next restart
+	for name := range applied {
+		if wanted[name] {
+			continue
+		}
+		log.Info("Resetting initialization parameter", "name", name)
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.SQLPlusScriptCMD, fmt.Sprintf(dbcommons.AlterSystemResetSQL, name)))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		// ORA-32010: cannot find entry to delete in SPFILE
+		if strings.Contains(out, "ORA-") && !strings.Contains(out, "ORA-32010") {
+			r.Recorder.Event(m, corev1.EventTypeWarning, "Invalid init-param", "Unable to reset the init-param "+name+". Error log: \n"+out)
+			continue
+		}
+		delete(applied, name)
+	}
+
+	if restart {
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Database Restarting", "Restarting the database to apply static initialization parameters")
+		m.Status.Status = dbcommons.StatusUpdating
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.RestartDatabaseCMD, dbcommons.SQLPlusCLI))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		log.Info("RestartDatabaseCMD Output:" + out)
+		if current, startupTime, err = r.getInstanceParameters(m, readyPod, ctx, req); err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		for name, a := range applied {
+			a.PendingRestart = false
+			applied[name] = a
+		}
+	} else if current, _, err = r.getInstanceParameters(m, readyPod, ctx, req); err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	// Report the parameters in the order of the spec with their effective values
+	var parametersStatus []dbapi.SingleInstanceDatabaseParameterStatus
+	for _, p := range m.Spec.Parameters {
+		if a, ok := applied[strings.ToLower(p.Name)]; ok {
+			a.EffectiveValue = current[a.Name].value
+			parametersStatus = append(parametersStatus, a)
+		}
+	}
+	for _, a := range applied {
+		// Reset failed, keep reporting the parameter
+		if !wanted[a.Name] {
+			a.EffectiveValue = current[a.Name].value
+			parametersStatus = append(parametersStatus, a)
+		}
+	}
+	m.Status.Parameters = parametersStatus
+	m.Status.InstanceStartupTime = startupTime
+
+	return requeueN, nil
+}
+
+// Reads the parameters of spec.parameters and status.parameters from v$parameter along with the instance startup time
+func (r *SingleInstanceDatabaseReconciler) getInstanceParameters(m *dbapi.SingleInstanceDatabase, readyPod corev1.Pod,
+	ctx context.Context, req ctrl.Request) (map[string]instanceParameter, string, error) {
+
+	var names []string
+	for _, p := range m.Spec.Parameters {
+		names = append(names, "'"+strings.ToLower(p.Name)+"'")
+	}
+	for _, p := range m.Status.Parameters {
+		names = append(names, "'"+p.Name+"'")
+	}
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.SQLPlusScriptCMD, fmt.Sprintf(dbcommons.GetParametersSQL, strings.Join(names, ","))))
+	if err != nil {
+		return nil, "", err
+	}
+	if strings.Contains(out, "ORA-") {
+		return nil, "", fmt.Errorf("error while getting database init params\n%s", out)
+	}
+	current := make(map[string]instanceParameter)
+	for _, line := range valuesWithPrefix(out, "param:") {
+		fields := strings.SplitN(line, "|", 3)
+		if len(fields) == 3 {
+			current[fields[0]] = instanceParameter{modifiable: fields[1], value: fields[2]}
+		}
+	}
+	startupTime := ""
+	if startup := valuesWithPrefix(out, "startup:"); len(startup) > 0 {
+		startupTime = startup[0]
+	}
+	return current, startupTime, nil
+}
@@ -0,0 +1,228 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// #############################################################################
+//
+//	Create, open and drop the pluggable databases listed in spec.pdbs.
+//	PDBs removed from the list are dropped only with the Delete policy
+//	recorded in the status when they were created
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) managePdbs(m *dbapi.SingleInstanceDatabase,
+	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("managePdbs", req.NamespacedName)
+
+	if len(m.Spec.Pdbs) == 0 && len(m.Status.Pdbs) == 0 {
+		return requeueN, nil
+	}
+
+	openModes, err := r.getPdbOpenModes(readyPod, ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, pdb := range m.Spec.Pdbs {
+		wanted[strings.ToUpper(pdb.Name)] = true
+	}
+
+	// Drop or release the PDBs removed from the spec
+	changed := false
+	dropFailed := make(map[string]bool)
+	for _, pdbStatus := range m.Status.Pdbs {
+		name := strings.ToUpper(pdbStatus.Name)
+		if wanted[name] {
+			continue
+		}
+		if _, found := openModes[name]; !found || pdbStatus.DeletionPolicy != dbcommons.DeletionPolicyDelete {
+			log.Info("Pluggable database released", "pdb", name)
+			continue
+		}
+		log.Info("Dropping pluggable database", "pdb", name)
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf("echo -e \"%s\" | %s", fmt.Sprintf(dbcommons.DropPdbSQL, name), dbcommons.SQLPlusCLI))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		log.Info("DropPdbSQL Output:\n" + out)
+		changed = true
+		if strings.Contains(out, "ORA-") {
+			// Kept in the status so that the drop is retried
+			dropFailed[name] = true
+			r.Recorder.Event(m, corev1.EventTypeWarning, "PDB Drop Failed",
+				fmt.Sprintf("Unable to drop pluggable database %s. Error log: \n%s", name, out))
+		}
+	}
+
+	// Create the PDBs added to the spec and open the ones left mounted
+	for _, pdb := range m.Spec.Pdbs {
+		name := strings.ToUpper(pdb.Name)
+		openMode, found := openModes[name]
+		if found && openMode == "READ_WRITE" {
+			continue
+		}
+		if !found {
+			sql := fmt.Sprintf(dbcommons.ClonePdbSQL, name, strings.ToUpper(pdb.CloneFrom))
+			if pdb.CloneFrom == "" {
+				adminPassword, err := GetDatabaseAdminPassword(r, m, ctx)
+				if err != nil {
+					log.Error(err, err.Error())
+					return requeueY, err
+				}
+				sql = fmt.Sprintf(dbcommons.CreatePdbFromSeedSQL, name, pdb.AdminName, adminPassword)
+			}
+			log.Info("Creating pluggable database", "pdb", name, "cloneFrom", pdb.CloneFrom)
+			out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, true, "bash", "-c",
+				fmt.Sprintf("echo -e \"%s\" | %s", sql, dbcommons.SQLPlusCLI))
+			if err != nil {
+				log.Error(err, err.Error())
+				return requeueY, err
+			}
+			if strings.Contains(out, "ORA-") {
+				r.Recorder.Event(m, corev1.EventTypeWarning, "PDB Creation Failed",
+					fmt.Sprintf("Unable to create pluggable database %s. Error log: \n%s", name, out))
+				continue
+			}
+		}
+		out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf("echo -e \"%s\" | %s", fmt.Sprintf(dbcommons.OpenPdbSQL, name), dbcommons.SQLPlusCLI))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		log.Info("OpenPdbSQL Output:\n" + out)
+		changed = true
+	}
+
+	if changed {
+		if openModes, err = r.getPdbOpenModes(readyPod, ctx, req); err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+	}
+
+	// Events for the PDBs created or dropped in this reconcile
+	previous := make(map[string]bool)
+	for _, pdbStatus := range m.Status.Pdbs {
+		name := strings.ToUpper(pdbStatus.Name)
+		previous[name] = true
+		if _, found := openModes[name]; !wanted[name] && !found {
+			r.Recorder.Eventf(m, corev1.EventTypeNormal, "PDB Dropped", "Pluggable database %s dropped", name)
+		}
+	}
+
+	var pdbsStatus []dbapi.SingleInstanceDatabasePdbStatus
+	for _, pdbStatus := range m.Status.Pdbs {
+		name := strings.ToUpper(pdbStatus.Name)
+		openMode, found := openModes[name]
+		if !dropFailed[name] || !found {
+			continue
+		}
+		pdbStatus.OpenMode = strings.ReplaceAll(openMode, "_", " ")
+		pdbsStatus = append(pdbsStatus, pdbStatus)
+	}
+	for _, pdb := range m.Spec.Pdbs {
+		name := strings.ToUpper(pdb.Name)
+		openMode, found := openModes[name]
+		if !found {
+			continue
+		}
+		if !previous[name] {
+			r.Recorder.Eventf(m, corev1.EventTypeNormal, "PDB Created", "Pluggable database %s created", name)
+		}
+		pdbsStatus = append(pdbsStatus, dbapi.SingleInstanceDatabasePdbStatus{
+			Name:              name,
+			OpenMode:          strings.ReplaceAll(openMode, "_", " "),
+			ConnectString:     pdbConnectString(m.Status.ConnectString, name),
+			TcpsConnectString: pdbConnectString(m.Status.TcpsConnectString, name),
+			DeletionPolicy:    pdb.DeletionPolicy,
+		})
+	}
+	m.Status.Pdbs = pdbsStatus
+
+	if len(pdbsStatus) != len(m.Spec.Pdbs) {
+		// Retry the PDBs which could not be created or dropped
+		return requeueY, nil
+	}
+	return requeueN, nil
+}
+
+// Returns the open mode of every pluggable database except the seed, keyed by PDB name
+func (r *SingleInstanceDatabaseReconciler) getPdbOpenModes(readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (map[string]string, error) {
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e \"%s\" | %s", dbcommons.GetPdbOpenModesSQL, dbcommons.SQLPlusCLI))
+	if err != nil {
+		return nil, err
+	}
+	if strings.Contains(out, "ORA-") {
+		return nil, fmt.Errorf("unable to list pluggable databases\n%s", out)
+	}
+	openModes := make(map[string]string)
+	for _, value := range valuesWithPrefix(out, "pdb:") {
+		if name, openMode, ok := strings.Cut(value, ":"); ok {
+			openModes[name] = openMode
+		}
+	}
+	return openModes, nil
+}
+
+// Derives the connect string of a PDB from the connect string of its container database
+func pdbConnectString(cdbConnectString string, pdbName string) string {
+	idx := strings.LastIndex(cdbConnectString, "/")
+	if idx < 0 || cdbConnectString == dbcommons.ValueUnavailable {
+		return ""
+	}
+	return cdbConnectString[:idx+1] + pdbName
+}
@@ -0,0 +1,210 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// #############################################################################
+//
+//	Create or delete the standby databases serving as read replicas of a
+//	primary database, and the read-only service balancing across them.
+//	Standbys are opened READ ONLY WITH APPLY by their own reconcile
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) manageReadReplicas(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("manageReadReplicas", req.NamespacedName)
+
+	if m.Spec.ReadReplicas == 0 && len(m.Status.ReadReplicas) == 0 {
+		return requeueN, nil
+	}
+
+	replicaList := &dbapi.SingleInstanceDatabaseList{}
+	if err := r.List(ctx, replicaList, client.InNamespace(m.Namespace),
+		client.MatchingLabels{dbcommons.ReadReplicaOfLabel: m.Name}); err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+	existing := make(map[string]*dbapi.SingleInstanceDatabase)
+	for i := range replicaList.Items {
+		existing[replicaList.Items[i].Name] = &replicaList.Items[i]
+	}
+
+	// Create the missing replicas
+	for i := 1; i <= m.Spec.ReadReplicas; i++ {
+		name := m.Name + "-rr-" + strconv.Itoa(i)
+		if _, ok := existing[name]; ok {
+			continue
+		}
+		replica := r.instantiateReadReplicaSpec(m, name, i)
+		log.Info("Creating read replica", "name", name, "sid", replica.Spec.Sid)
+		if err := r.Create(ctx, replica); err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		existing[name] = replica
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Read Replica Created", "Read replica %s created with sid %s", name, replica.Spec.Sid)
+	}
+
+	// Delete the replicas above the requested count
+	for name, replica := range existing {
+		index, err := strconv.Atoi(strings.TrimPrefix(name, m.Name+"-rr-"))
+		if err == nil && index <= m.Spec.ReadReplicas {
+			continue
+		}
+		if replica.Status.DgBroker != nil {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Read Replica Not Deleted",
+				"Read replica %s is part of the DataGuard Broker configuration %s, remove it from the configuration first", name, *replica.Status.DgBroker)
+			continue
+		}
+		log.Info("Deleting read replica", "name", name)
+		if err := r.Delete(ctx, replica); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		delete(existing, name)
+		delete(m.Status.StandbyDatabases, strings.ToUpper(replica.Spec.Sid))
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Read Replica Deleted", "Read replica %s deleted", name)
+	}
+
+	// Read-only service over the pods of all replicas
+	roSvcName := m.Name + "-ro"
+	roSvc := &corev1.Service{}
+	err := r.Get(ctx, types.NamespacedName{Name: roSvcName, Namespace: m.Namespace}, roSvc)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return requeueY, err
+	}
+	if len(existing) == 0 {
+		if err == nil {
+			log.Info("Deleting read-only service", "Service.Name", roSvcName)
+			if err := r.Delete(ctx, roSvc); err != nil && !apierrors.IsNotFound(err) {
+				return requeueY, err
+			}
+		}
+		m.Status.ReadReplicas = nil
+		m.Status.ReadOnlyConnectString = ""
+		return requeueN, nil
+	}
+	if apierrors.IsNotFound(err) {
+		ports := []corev1.ServicePort{{Name: "listener", Port: dbcommons.CONTAINER_LISTENER_PORT, Protocol: corev1.ProtocolTCP}}
+		svc := r.instantiateSVCSpec(m, roSvcName, ports, corev1.ServiceType("ClusterIP"), false)
+		svc.Spec.Selector = map[string]string{dbcommons.ReadReplicaOfLabel: m.Name}
+		log.Info("Creating read-only service", "Service.Namespace", svc.Namespace, "Service.Name", svc.Name)
+		if err := r.Create(ctx, svc); err != nil {
+			log.Error(err, "Failed to create read-only service", "Service.Name", svc.Name)
+			return requeueY, err
+		}
+	}
+
+	var names []string
+	for name := range existing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	m.Status.ReadReplicas = names
+	service := m.Status.Pdbname
+	if service == "" {
+		service = m.Status.Sid
+	}
+	m.Status.ReadOnlyConnectString = roSvcName + "." + m.Namespace + ":" + fmt.Sprint(dbcommons.CONTAINER_LISTENER_PORT) + "/" + strings.ToUpper(service)
+
+	return requeueN, nil
+}
+
+// #############################################################################
+//
+//	Instantiate the standby database spec of a read replica
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) instantiateReadReplicaSpec(m *dbapi.SingleInstanceDatabase, name string, index int) *dbapi.SingleInstanceDatabase {
+	sid := strings.ToUpper(m.Spec.Sid)
+	if len(sid) > 8 {
+		sid = sid[:8]
+	}
+	replica := &dbapi.SingleInstanceDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: m.Namespace,
+			Labels: map[string]string{
+				dbcommons.ReadReplicaOfLabel: m.Name,
+			},
+		},
+		Spec: dbapi.SingleInstanceDatabaseSpec{
+			Sid:                sid + "RR" + strconv.Itoa(index),
+			Edition:            m.Spec.Edition,
+			CreateAs:           "standby",
+			PrimaryDatabaseRef: m.Name,
+			AdminPassword:      m.Spec.AdminPassword,
+			Image:              m.Spec.Image,
+			Persistence: dbapi.SingleInstanceDatabasePersistence{
+				Size:                  m.Spec.Persistence.Size,
+				StorageClass:          m.Spec.Persistence.StorageClass,
+				AccessMode:            m.Spec.Persistence.AccessMode,
+				VolumeClaimAnnotation: m.Spec.Persistence.VolumeClaimAnnotation,
+				SetWritePermissions:   m.Spec.Persistence.SetWritePermissions,
+			},
+			Resources:          m.Spec.Resources,
+			NodeSelector:       m.Spec.NodeSelector,
+			Tolerations:        m.Spec.Tolerations,
+			PriorityClassName:  m.Spec.PriorityClassName,
+			ServiceAccountName: m.Spec.ServiceAccountName,
+			Replicas:           1,
+		},
+	}
+	replica.Spec.Image.PrebuiltDB = false
+	ctrl.SetControllerReference(m, replica, r.Scheme)
+	return replica
+}
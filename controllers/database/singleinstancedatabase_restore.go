@@ -0,0 +1,372 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// #############################################################################
+//
+//	Restore a database created with createAs restore from its backup.
+//	The database container waits for the restore marker, so the controlfile,
+//	datafiles and recovery are handled here through the pod before it starts
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) restoreDatabase(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("restoreDatabase", req.NamespacedName)
+
+	phase := dbcommons.RestorePendingReason
+	if cond := meta.FindStatusCondition(m.Status.Conditions, dbcommons.DatabaseRestored); cond != nil {
+		if cond.Status == metav1.ConditionTrue {
+			return requeueN, nil
+		}
+		if cond.Reason == dbcommons.RestoreFailedReason {
+			m.Status.Status = dbcommons.StatusError
+			return requeueN, errors.New(cond.Message)
+		}
+		phase = cond.Reason
+	}
+
+	backup := &dbapi.SingleInstanceDatabaseBackup{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: m.Spec.RestoreFrom.BackupRef}, backup)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Restore Pending", "SingleInstanceDatabaseBackup %s not found", m.Spec.RestoreFrom.BackupRef)
+			r.setRestoreCondition(m, metav1.ConditionFalse, dbcommons.RestorePendingReason, "waiting for backup "+m.Spec.RestoreFrom.BackupRef)
+			return requeueY, nil
+		}
+		return requeueY, err
+	}
+
+	record, err := selectRestoreBackup(m.Spec.RestoreFrom, backup)
+	if err == nil && !strings.EqualFold(m.Spec.Sid, backup.Status.Sid) {
+		err = fmt.Errorf("sid must be %s, the sid of the backed up database", backup.Status.Sid)
+	}
+	if err == nil && backup.Spec.Destination.Type != "s3" && m.Spec.Persistence.BackupVolumeClaimName == "" {
+		err = errors.New("persistence.backupVolumeClaimName must refer to the backup volume claim of " + backup.Spec.SingleInstanceDatabaseRef)
+	}
+	if err != nil {
+		return r.failRestore(m, err)
+	}
+
+	readyPod, _, available, _, err := dbcommons.FindPods(r, m.Spec.Image.Version,
+		m.Spec.Image.PullFrom, m.Name, m.Namespace, ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+	pod := readyPod
+	if pod.Name == "" {
+		ok, runningPod := dbcommons.IsAnyPodWithStatus(available, corev1.PodRunning)
+		if !ok {
+			log.Info("Waiting for the database pod to restore into")
+			r.setRestoreCondition(m, metav1.ConditionFalse, dbcommons.RestorePendingReason, "waiting for the database pod")
+			return requeueY, nil
+		}
+		pod = runningPod
+	}
+	m.Status.Status = dbcommons.StatusCreating
+
+	channel := dbcommons.RmanRestoreDiskChannelCMD
+	if backup.Spec.Destination.Type == "s3" {
+		if err := setupObjectStoreModule(r, r.Config, backup, pod, ctx, req); err != nil {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Restore Pending", err.Error())
+			return requeueY, nil
+		}
+		channel = fmt.Sprintf(dbcommons.RmanSbtChannelCMD, dbcommons.ObjectStoreLibLocation, backup.Name)
+	}
+	logName := "restore_" + strings.ToLower(m.Name)
+
+	switch phase {
+	case dbcommons.RestorePendingReason, dbcommons.RestoringControlfileReason:
+		r.setRestoreCondition(m, metav1.ConditionFalse, dbcommons.RestoringControlfileReason,
+			fmt.Sprintf("restoring spfile and controlfile of backup %s", record.Tag))
+		r.Status().Update(ctx, m)
+
+		if err := r.restoreControlfile(m, pod, channel, record, ctx, req); err != nil {
+			return r.failRestore(m, err)
+		}
+
+		script, err := buildRmanRestoreScript(m.Spec.RestoreFrom, backup, record, channel)
+		if err != nil {
+			return r.failRestore(m, err)
+		}
+		_, err = dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.RmanRunInBackgroundCMD, dbcommons.RmanLogLocation, logName, script))
+		if err != nil {
+			return r.failRestore(m, err)
+		}
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Restoring Database", "restoring datafiles of backup %s", record.Tag)
+		r.setRestoreCondition(m, metav1.ConditionFalse, dbcommons.RestoringDatabaseReason,
+			fmt.Sprintf("restoring and recovering datafiles of backup %s", record.Tag))
+		return requeueY, nil
+
+	case dbcommons.RestoringDatabaseReason:
+		out, err := dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf(dbcommons.RmanJobStatusCMD, dbcommons.RmanLogLocation, logName))
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, nil
+		}
+		if strings.Contains(out, "rman_status:RUNNING") {
+			log.Info("Database restore in progress")
+			return requeueY, nil
+		}
+		if !strings.Contains(out, "rman_status:COMPLETED") {
+			return r.failRestore(m, fmt.Errorf("restore of backup %s failed, see %s/%s.log\n%s", record.Tag,
+				dbcommons.RmanLogLocation, logName, strings.TrimSpace(strings.Replace(out, "rman_status:FAILED", "", 1))))
+		}
+
+		adminPassword, err := GetDatabaseAdminPassword(r, m, ctx)
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, nil
+		}
+		edition := m.Spec.Edition
+		if edition == "" {
+			edition = "enterprise"
+		}
+		// nologCommand as the command carries the admin password
+		out, err = dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, true, "bash", "-c",
+			fmt.Sprintf(dbcommons.RestoreFinalizeCMD, adminPassword, edition))
+		if err != nil || strings.Contains(out, "ORA-") || strings.Contains(out, "OPW-") {
+			return r.failRestore(m, fmt.Errorf("unable to configure the restored database: %v %s", err, out))
+		}
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Database Restored", "database restored from backup %s", record.Tag)
+		r.setRestoreCondition(m, metav1.ConditionTrue, dbcommons.RestoreCompleteReason,
+			fmt.Sprintf("database restored from backup %s and opened with resetlogs", record.Tag))
+		// Database starts in the pod now, continue with the readiness checks
+		return requeueY, nil
+	}
+	return requeueN, nil
+}
+
+// #############################################################################
+//
+//	Restore spfile and controlfile, mount the database and create the
+//	directories the datafiles, tempfiles and redo logs are restored to
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) restoreControlfile(m *dbapi.SingleInstanceDatabase, pod corev1.Pod, channel string,
+	record *dbapi.SingleInstanceDatabaseBackupRecord, ctx context.Context, req ctrl.Request) error {
+
+	spfilePiece := ""
+	for _, piece := range record.Pieces {
+		if strings.Contains(piece, "_spf_") {
+			spfilePiece = piece
+		}
+	}
+	if spfilePiece == "" || record.ControlFilePiece == "" {
+		return fmt.Errorf("backup %s has no spfile or controlfile piece", record.Tag)
+	}
+
+	out, err := dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.RestoreSpfileCMD, channel, spfilePiece))
+	if err != nil || strings.Contains(out, "RMAN-00569") {
+		return fmt.Errorf("spfile restore failed: %v %s", err, out)
+	}
+	if err := r.createRestoreDirectories(pod, dbcommons.RestoreInstanceDirectoriesSQL, ctx, req); err != nil {
+		return err
+	}
+
+	out, err = dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.RestoreControlfileCMD, channel, record.ControlFilePiece))
+	if err != nil || strings.Contains(out, "RMAN-00569") {
+		return fmt.Errorf("controlfile restore failed: %v %s", err, out)
+	}
+	return r.createRestoreDirectories(pod, dbcommons.RestoreDatafileDirectoriesSQL, ctx, req)
+}
+
+// Creates the directories listed by sql as "dir:<path>" lines
+func (r *SingleInstanceDatabaseReconciler) createRestoreDirectories(pod corev1.Pod, sql string, ctx context.Context, req ctrl.Request) error {
+	out, err := dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e  \"%s\"  | %s", sql, dbcommons.SQLPlusCLI))
+	if err != nil {
+		return err
+	}
+	if strings.Contains(out, "ORA-") {
+		return fmt.Errorf("unable to list database directories\n%s", out)
+	}
+	dirs := valuesWithPrefix(out, "dir:")
+	if len(dirs) == 0 {
+		return nil
+	}
+	_, err = dbcommons.ExecCommand(r, r.Config, pod.Name, pod.Namespace, "", ctx, req, false, "bash", "-c",
+		"mkdir -p "+strings.Join(dirs, " "))
+	return err
+}
+
+// #############################################################################
+//
+//	Pick the backup to restore: the requested tag, else the latest database
+//	backup taken before the recovery target, else the last successful one
+//
+// #############################################################################
+func selectRestoreBackup(from *dbapi.SingleInstanceDatabaseRestoreSource, b *dbapi.SingleInstanceDatabaseBackup) (*dbapi.SingleInstanceDatabaseBackupRecord, error) {
+	var targetScn uint64
+	var targetTime time.Time
+	var err error
+	if from.Scn != "" {
+		if targetScn, err = strconv.ParseUint(from.Scn, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid restoreFrom.scn %s", from.Scn)
+		}
+	}
+	if from.Timestamp != "" {
+		if targetTime, err = time.Parse("2006-01-02 15:04:05", from.Timestamp); err != nil {
+			return nil, fmt.Errorf("invalid restoreFrom.timestamp %s", from.Timestamp)
+		}
+	}
+
+	var selected *dbapi.SingleInstanceDatabaseBackupRecord
+	for i := range b.Status.Backups {
+		rec := &b.Status.Backups[i]
+		if rec.Status != dbcommons.StatusBackupCompleted || rec.BackupType == "archivelog" {
+			continue
+		}
+		if from.Tag != "" {
+			if rec.Tag == from.Tag {
+				selected = rec
+			}
+			continue
+		}
+		scn, _ := strconv.ParseUint(rec.Scn, 10, 64)
+		// a backup without a recorded SCN cannot be placed before the target SCN
+		if targetScn > 0 && (rec.Scn == "" || scn >= targetScn) {
+			continue
+		}
+		if !targetTime.IsZero() && (rec.CompletionTime == nil || !rec.CompletionTime.Time.Before(targetTime)) {
+			continue
+		}
+		// Records are kept in the order the backups were taken
+		selected = rec
+	}
+	if selected == nil {
+		if from.Tag != "" {
+			return nil, fmt.Errorf("no completed database backup with tag %s in %s", from.Tag, b.Name)
+		}
+		return nil, fmt.Errorf("no completed database backup in %s before the recovery target", b.Name)
+	}
+	if selected.Scn == "" {
+		return nil, fmt.Errorf("backup %s has no recorded SCN", selected.Tag)
+	}
+	return selected, nil
+}
+
+// Builds the RMAN command file restoring and recovering the datafiles up to the recovery target
+func buildRmanRestoreScript(from *dbapi.SingleInstanceDatabaseRestoreSource, b *dbapi.SingleInstanceDatabaseBackup,
+	record *dbapi.SingleInstanceDatabaseBackupRecord, channel string) (string, error) {
+
+	var script []string
+	pointInTime := from.Scn != "" || from.Timestamp != ""
+	if b.Spec.Destination.Type == "s3" {
+		if pointInTime {
+			// Pieces of later backups are needed to recover past the restored controlfile
+			var pieces []string
+			later := false
+			for _, rec := range b.Status.Backups {
+				if later && rec.Status == dbcommons.StatusBackupCompleted {
+					for _, piece := range rec.Pieces {
+						pieces = append(pieces, "'"+piece+"'")
+					}
+				}
+				later = later || rec.Tag == record.Tag
+			}
+			if len(pieces) > 0 {
+				script = append(script, fmt.Sprintf(dbcommons.RmanConfigureSbtChannelCMD, dbcommons.ObjectStoreLibLocation, b.Name),
+					fmt.Sprintf(dbcommons.RmanCatalogSbtPiecesCMD, strings.Join(pieces, ", ")))
+			}
+		}
+	} else {
+		script = append(script, fmt.Sprintf(dbcommons.RmanCatalogLocationCMD, path.Dir(record.ControlFilePiece)))
+	}
+
+	script = append(script, "RUN {", channel)
+	switch {
+	case from.Scn != "":
+		script = append(script, fmt.Sprintf(dbcommons.RmanSetUntilScnCMD, from.Scn))
+	case from.Timestamp != "":
+		script = append(script, fmt.Sprintf(dbcommons.RmanSetUntilTimeCMD, from.Timestamp))
+	default:
+		// Recover to the end of the backup
+		scn, err := strconv.ParseUint(record.Scn, 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("invalid SCN %s recorded for backup %s", record.Scn, record.Tag)
+		}
+		script = append(script, fmt.Sprintf(dbcommons.RmanSetUntilScnCMD, strconv.FormatUint(scn+1, 10)))
+	}
+	script = append(script, dbcommons.RmanRestoreRecoverCMD, "}", dbcommons.OpenResetlogsSQL)
+	return strings.Join(script, "\n"), nil
+}
+
+func (r *SingleInstanceDatabaseReconciler) setRestoreCondition(m *dbapi.SingleInstanceDatabase, status metav1.ConditionStatus, reason string, message string) {
+	meta.SetStatusCondition(&m.Status.Conditions, metav1.Condition{
+		Type:               dbcommons.DatabaseRestored,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: m.Generation,
+	})
+}
+
+func (r *SingleInstanceDatabaseReconciler) failRestore(m *dbapi.SingleInstanceDatabase, err error) (ctrl.Result, error) {
+	r.Log.Error(err, "database restore failed")
+	r.Recorder.Event(m, corev1.EventTypeWarning, "Restore Failed", err.Error())
+	r.setRestoreCondition(m, metav1.ConditionFalse, dbcommons.RestoreFailedReason, err.Error())
+	m.Status.Status = dbcommons.StatusError
+	return requeueN, err
+}
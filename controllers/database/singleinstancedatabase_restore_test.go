@@ -0,0 +1,103 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelectRestoreBackup(t *testing.T) {
+	completed := func(tag, backupType, scn string, completion time.Time) dbapi.SingleInstanceDatabaseBackupRecord {
+		completionTime := metav1.NewTime(completion)
+		return dbapi.SingleInstanceDatabaseBackupRecord{Tag: tag, BackupType: backupType, Status: dbcommons.StatusBackupCompleted,
+			Scn: scn, CompletionTime: &completionTime}
+	}
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+
+	backup := &dbapi.SingleInstanceDatabaseBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "backup"},
+		Status: dbapi.SingleInstanceDatabaseBackupStatus{
+			Backups: []dbapi.SingleInstanceDatabaseBackupRecord{
+				completed("F_1", "full", "1000", day(1)),
+				completed("A_1", "archivelog", "1500", day(2)),
+				completed("I_1", "incremental", "2000", day(3)),
+				{Tag: "F_2", BackupType: "full", Status: "Failed", Scn: "2500"},
+				completed("F_3", "full", "3000", day(5)),
+				completed("F_4", "full", "", day(6)),
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		from    dbapi.SingleInstanceDatabaseRestoreSource
+		wantTag string
+		wantErr bool
+	}{
+		{name: "tag", from: dbapi.SingleInstanceDatabaseRestoreSource{Tag: "I_1"}, wantTag: "I_1"},
+		{name: "unknown tag", from: dbapi.SingleInstanceDatabaseRestoreSource{Tag: "F_9"}, wantErr: true},
+		{name: "failed backup tag", from: dbapi.SingleInstanceDatabaseRestoreSource{Tag: "F_2"}, wantErr: true},
+		{name: "archivelog tag", from: dbapi.SingleInstanceDatabaseRestoreSource{Tag: "A_1"}, wantErr: true},
+		{name: "last backup without scn", from: dbapi.SingleInstanceDatabaseRestoreSource{}, wantErr: true},
+		{name: "before scn", from: dbapi.SingleInstanceDatabaseRestoreSource{Scn: "2999"}, wantTag: "I_1"},
+		{name: "scn of a backup", from: dbapi.SingleInstanceDatabaseRestoreSource{Scn: "3000"}, wantTag: "I_1"},
+		{name: "scn before any backup", from: dbapi.SingleInstanceDatabaseRestoreSource{Scn: "500"}, wantErr: true},
+		{name: "invalid scn", from: dbapi.SingleInstanceDatabaseRestoreSource{Scn: "abc"}, wantErr: true},
+		{name: "before timestamp", from: dbapi.SingleInstanceDatabaseRestoreSource{Timestamp: "2024-01-04 00:00:00"}, wantTag: "I_1"},
+		{name: "timestamp before any backup", from: dbapi.SingleInstanceDatabaseRestoreSource{Timestamp: "2023-12-31 00:00:00"}, wantErr: true},
+		{name: "invalid timestamp", from: dbapi.SingleInstanceDatabaseRestoreSource{Timestamp: "2024-01-04"}, wantErr: true},
+		{name: "scn and timestamp", from: dbapi.SingleInstanceDatabaseRestoreSource{Scn: "5000", Timestamp: "2024-01-02 00:00:00"}, wantTag: "F_1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectRestoreBackup(&tt.from, backup)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectRestoreBackup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Tag != tt.wantTag {
+				t.Errorf("selectRestoreBackup() = %s, want %s", got.Tag, tt.wantTag)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// #############################################################################
+//
+//	Standby-first patching of a primary database with standbys
+//	Standbys are patched first, the DataguardBroker switches over to a
+//	patched standby, then this database is patched and optionally switched back
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) manageRollingPatch(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("manageRollingPatch", req.NamespacedName)
+
+	if m.Status.RollingPatch == nil {
+		if m.Spec.Patching == nil || m.Spec.Patching.Strategy != "standbyFirst" ||
+			strings.ToUpper(m.Status.Role) != "PRIMARY" || len(m.Status.StandbyDatabases) == 0 {
+			return requeueN, nil
+		}
+		readyPod, _, _, _, err := dbcommons.FindPods(r, "", "", m.Name, m.Namespace, ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		if readyPod.Name == "" || (readyPod.Labels["version"] == m.Spec.Image.Version &&
+			readyPod.Spec.Containers[0].Image == m.Spec.Image.PullFrom) {
+			return requeueN, nil
+		}
+		if m.Status.DgBroker == nil {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Rolling Patch", "database not in a DataguardBroker configuration, patching in place")
+			log.Info("No DataguardBroker to switch over, patching in place")
+			return requeueN, nil
+		}
+		m.Status.RollingPatch = &dbapi.SingleInstanceDatabaseRollingPatchStatus{
+			Phase:     dbcommons.RollingPatchStandbysPhase,
+			Image:     m.Spec.Image.PullFrom,
+			Version:   m.Spec.Image.Version,
+			FromImage: readyPod.Spec.Containers[0].Image,
+		}
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Rolling Patch", "patching standby databases to %s", m.Spec.Image.PullFrom)
+		r.setRollingPatchCondition(m, metav1.ConditionFalse, dbcommons.RollingPatchStandbysPhase,
+			"patching standby databases to "+m.Spec.Image.PullFrom)
+		return requeueY, nil
+	}
+
+	rp := m.Status.RollingPatch
+	if rp.Image != m.Spec.Image.PullFrom || rp.Version != m.Spec.Image.Version {
+		r.Recorder.Eventf(m, corev1.EventTypeWarning, "Rolling Patch", "image cannot be changed while a rolling patch to %s is in progress", rp.Image)
+		return requeueY, nil
+	}
+
+	switch rp.Phase {
+	case dbcommons.RollingPatchStandbysPhase:
+		patched, err := r.patchStandbyDatabases(m, ctx, req)
+		if err != nil {
+			return requeueY, err
+		}
+		if !patched {
+			log.Info("Waiting for standby databases to be patched")
+			return requeueY, nil
+		}
+		target, err := r.getSwitchoverTarget(m, ctx)
+		if err != nil {
+			r.Recorder.Event(m, corev1.EventTypeWarning, "Rolling Patch", err.Error())
+			return requeueY, nil
+		}
+		rp.SwitchoverTarget = target
+		rp.Phase = dbcommons.RollingPatchSwitchoverPhase
+		r.setRollingPatchCondition(m, metav1.ConditionFalse, rp.Phase, "switching over to patched standby database "+target)
+		return requeueY, nil
+
+	case dbcommons.RollingPatchSwitchoverPhase:
+		done, err := r.switchoverForRollingPatch(m, rp.SwitchoverTarget, ctx)
+		if err != nil || !done {
+			return requeueY, err
+		}
+		rp.Phase = dbcommons.RollingPatchPrimaryPhase
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Rolling Patch", "switched over to %s, patching database", rp.SwitchoverTarget)
+		r.setRollingPatchCondition(m, metav1.ConditionFalse, rp.Phase, "patching database to "+rp.Image)
+		return requeueY, nil
+
+	case dbcommons.RollingPatchPrimaryPhase:
+		m.Status.Status = dbcommons.StatusPatching
+		readyPod, _, _, _, err := dbcommons.FindPods(r, rp.Version, rp.Image, m.Name, m.Namespace, ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return requeueY, err
+		}
+		if readyPod.Name == "" {
+			// Pods are replaced by createOrReplacePods
+			return requeueN, nil
+		}
+		rp.Phase = dbcommons.RollingPatchDatapatchPhase
+		r.setRollingPatchCondition(m, metav1.ConditionFalse, rp.Phase, "waiting for datapatch on primary database "+rp.SwitchoverTarget)
+		return requeueY, nil
+
+	case dbcommons.RollingPatchDatapatchPhase:
+		// Datapatch runs once, from the reconcile of the new primary, the changes reach this database through redo apply
+		newPrimary := &dbapi.SingleInstanceDatabase{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: m.Status.StandbyDatabases[rp.SwitchoverTarget]}, newPrimary)
+		if err != nil {
+			return requeueY, err
+		}
+		if newPrimary.Status.DatafilesPatched != "true" {
+			log.Info("Waiting for datapatch on " + newPrimary.Name)
+			return requeueY, nil
+		}
+		m.Status.DatafilesPatched = "true"
+		if m.Spec.Patching.SwitchBack {
+			rp.Phase = dbcommons.RollingPatchSwitchbackPhase
+			r.setRollingPatchCondition(m, metav1.ConditionFalse, rp.Phase, "switching back to "+strings.ToUpper(m.Spec.Sid))
+			return requeueY, nil
+		}
+
+	case dbcommons.RollingPatchSwitchbackPhase:
+		done, err := r.switchoverForRollingPatch(m, strings.ToUpper(m.Spec.Sid), ctx)
+		if err != nil || !done {
+			return requeueY, err
+		}
+	}
+
+	r.Recorder.Eventf(m, corev1.EventTypeNormal, "Rolling Patch", "database and standby databases patched to %s", rp.Image)
+	r.setRollingPatchCondition(m, metav1.ConditionTrue, dbcommons.RollingPatchCompleteReason,
+		"database and standby databases patched to "+rp.Image)
+	m.Status.RollingPatch = nil
+	return requeueY, nil
+}
+
+// Updates the image of the standby databases and returns true once all of them run the new image
+func (r *SingleInstanceDatabaseReconciler) patchStandbyDatabases(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (bool, error) {
+
+	rp := m.Status.RollingPatch
+	patched := true
+	for _, name := range m.Status.StandbyDatabases {
+		standby := &dbapi.SingleInstanceDatabase{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: name}, standby); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, err
+		}
+		if standby.Spec.Image.PullFrom != rp.Image || standby.Spec.Image.Version != rp.Version {
+			standby.Spec.Image.PullFrom = rp.Image
+			standby.Spec.Image.Version = rp.Version
+			standby.Spec.Image.PullSecrets = m.Spec.Image.PullSecrets
+			if err := r.Update(ctx, standby); err != nil {
+				return false, err
+			}
+			r.Recorder.Eventf(m, corev1.EventTypeNormal, "Rolling Patch", "patching standby database %s", standby.Name)
+			patched = false
+			continue
+		}
+		readyPod, _, _, _, err := dbcommons.FindPods(r, rp.Version, rp.Image, standby.Name, standby.Namespace, ctx, req)
+		if err != nil {
+			return false, err
+		}
+		if readyPod.Name == "" || standby.Status.Status != dbcommons.StatusReady {
+			patched = false
+		}
+	}
+	return patched, nil
+}
+
+// Returns the sid of the first physical standby database in the dataguard configuration
+func (r *SingleInstanceDatabaseReconciler) getSwitchoverTarget(m *dbapi.SingleInstanceDatabase, ctx context.Context) (string, error) {
+	broker := &dbapi.DataguardBroker{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: *m.Status.DgBroker}, broker); err != nil {
+		return "", err
+	}
+	var sids []string
+	for sid := range m.Status.StandbyDatabases {
+		sids = append(sids, sid)
+	}
+	sort.Strings(sids)
+	for _, sid := range sids {
+		if _, ok := broker.Status.DatabasesInDataguardConfig[sid]; !ok {
+			continue
+		}
+		standby := &dbapi.SingleInstanceDatabase{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: m.Status.StandbyDatabases[sid]}, standby); err != nil {
+			continue
+		}
+		if standby.Status.Role == "PHYSICAL_STANDBY" {
+			return sid, nil
+		}
+	}
+	return "", fmt.Errorf("no physical standby database in dataguardbroker %s to switch over to", broker.Name)
+}
+
+// Sets the primary database of the DataguardBroker and returns true once the switchover is done
+func (r *SingleInstanceDatabaseReconciler) switchoverForRollingPatch(m *dbapi.SingleInstanceDatabase, sid string, ctx context.Context) (bool, error) {
+	broker := &dbapi.DataguardBroker{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: m.Namespace, Name: *m.Status.DgBroker}, broker); err != nil {
+		return false, err
+	}
+	if strings.EqualFold(broker.Status.PrimaryDatabase, sid) {
+		return true, nil
+	}
+	if broker.Spec.SetAsPrimaryDatabase != sid {
+		broker.Spec.SetAsPrimaryDatabase = sid
+		if err := r.Update(ctx, broker); err != nil {
+			return false, err
+		}
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Rolling Patch", "switching over to %s", sid)
+	}
+	r.Log.Info("Waiting for switchover", "target", sid)
+	return false, nil
+}
+
+func (r *SingleInstanceDatabaseReconciler) setRollingPatchCondition(m *dbapi.SingleInstanceDatabase, status metav1.ConditionStatus, reason string, message string) {
+	meta.SetStatusCondition(&m.Status.Conditions, metav1.Condition{
+		Type:               dbcommons.RollingPatchCondition,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: m.Generation,
+	})
+}
@@ -0,0 +1,208 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var certificateGVK = schema.GroupVersionKind{Group: "cert-manager.io", Version: "v1", Kind: "Certificate"}
+
+// Returns the name of the secret holding the TCPS certificate, issued by cert-manager or provided in tcpsTlsSecret
+func tcpsTlsSecretName(m *dbapi.SingleInstanceDatabase) string {
+	if m.Spec.TcpsCertIssuerRef != nil {
+		return m.Name + "-tcps-tls"
+	}
+	return m.Spec.TcpsTlsSecret
+}
+
+// #############################################################################
+//
+//	Request the TCPS certificate for the database services from cert-manager
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) manageTcpsCertificate(m *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("manageTcpsCertificate", req.NamespacedName)
+
+	if !m.Spec.EnableTCPS || m.Spec.TcpsCertIssuerRef == nil {
+		return requeueN, nil
+	}
+
+	issuer := m.Spec.TcpsCertIssuerRef
+	var dnsNames []interface{}
+	for _, svc := range []string{m.Name, m.Name + "-ext"} {
+		dnsNames = append(dnsNames, svc, svc+"."+m.Namespace, svc+"."+m.Namespace+".svc",
+			svc+"."+m.Namespace+".svc.cluster.local")
+	}
+
+	cert := &unstructured.Unstructured{}
+	cert.SetGroupVersionKind(certificateGVK)
+	cert.SetName(tcpsTlsSecretName(m))
+	cert.SetNamespace(m.Namespace)
+	result, err := controllerutil.CreateOrUpdate(ctx, r.Client, cert, func() error {
+		spec := map[string]interface{}{
+			"secretName": tcpsTlsSecretName(m),
+			"dnsNames":   dnsNames,
+			"issuerRef": map[string]interface{}{
+				"name":  issuer.Name,
+				"kind":  issuer.Kind,
+				"group": issuer.Group,
+			},
+			"privateKey": map[string]interface{}{
+				"algorithm":      "RSA",
+				"size":           int64(2048),
+				"rotationPolicy": "Always",
+			},
+			"usages": []interface{}{"server auth", "client auth", "digital signature", "key encipherment"},
+			// Label the secret so that its rotation triggers a reconcile of the database
+			"secretTemplate": map[string]interface{}{
+				"labels": map[string]interface{}{dbcommons.TcpsCertSecretLabel: m.Name},
+			},
+		}
+		if issuer.Duration != "" {
+			spec["duration"] = issuer.Duration
+		}
+		cert.Object["spec"] = spec
+		return ctrl.SetControllerReference(m, cert, r.Scheme)
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			r.Recorder.Eventf(m, corev1.EventTypeWarning, "Configuring TCPS", "cert-manager Certificate API not found, install cert-manager to use tcpsCertIssuerRef")
+			return requeueN, err
+		}
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+	if result != controllerutil.OperationResultNone {
+		r.Recorder.Eventf(m, corev1.EventTypeNormal, "Configuring TCPS", "certificate %s %s", cert.GetName(), result)
+		log.Info("Certificate "+string(result), "name", cert.GetName())
+	}
+	return requeueN, nil
+}
+
+// Returns the checksum of the certificate in the TCPS tls secret
+func (r *SingleInstanceDatabaseReconciler) getTcpsCertChecksum(m *dbapi.SingleInstanceDatabase, ctx context.Context) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Name: tcpsTlsSecretName(m), Namespace: m.Namespace}, secret); err != nil {
+		return "", err
+	}
+	if len(secret.Data["tls.crt"]) == 0 {
+		return "", apierrors.NewNotFound(corev1.Resource("secrets"), secret.Name+"/tls.crt")
+	}
+	sum := sha256.Sum256(secret.Data["tls.crt"])
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// #############################################################################
+//
+//	Re-import a certificate rotated by cert-manager into the database wallet
+//	and the client wallet. The listener is reconfigured, the database keeps running
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseReconciler) rotateTcpsCertificate(m *dbapi.SingleInstanceDatabase,
+	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("rotateTcpsCertificate", req.NamespacedName)
+
+	checksum, err := r.getTcpsCertChecksum(m, ctx)
+	if err != nil {
+		log.Info("TCPS certificate secret not available", "secret", tcpsTlsSecretName(m), "error", err.Error())
+		return requeueY, nil
+	}
+	if checksum == m.Status.TcpsCertChecksum {
+		return requeueN, nil
+	}
+
+	// Secret volumes are refreshed by the kubelet, wait for the new certificate in the pod
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "",
+		ctx, req, false, "bash", "-c", dbcommons.TlsCertChecksumCMD)
+	if err != nil || strings.TrimSpace(out) != checksum {
+		log.Info("Waiting for the rotated certificate to be mounted in the pod", "pod", readyPod.Name)
+		return requeueY, nil
+	}
+
+	m.Status.Status = dbcommons.StatusUpdating
+	r.Status().Update(ctx, m)
+	out, err = dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "",
+		ctx, req, false, "bash", "-c", "export TCPS_CERTS_LOCATION="+dbcommons.TlsCertsLocation+" && "+dbcommons.EnableTcpsCMD)
+	if err != nil {
+		log.Error(err, err.Error())
+		r.Recorder.Eventf(m, corev1.EventTypeWarning, "Configuring TCPS", "Error encountered in importing the rotated certificate!")
+		return requeueY, nil
+	}
+	log.Info("Certificate import output : \n" + out)
+	if err := r.updateClientWallet(m, readyPod, ctx, req); err != nil {
+		log.Error(err, "Error in updating tnsnames.ora in clientWallet...")
+		return requeueY, nil
+	}
+
+	m.Status.TcpsCertChecksum = checksum
+	m.Status.CertCreationTimestamp = time.Now().Format(time.RFC3339)
+	r.Recorder.Eventf(m, corev1.EventTypeNormal, "Configuring TCPS", "rotated certificate from secret %s imported", tcpsTlsSecretName(m))
+	return requeueN, nil
+}
+
+// Maps a TCPS certificate secret issued by cert-manager to its database
+func (r *SingleInstanceDatabaseReconciler) tcpsCertSecretToDatabase(ctx context.Context, o client.Object) []reconcile.Request {
+	name, ok := o.GetLabels()[dbcommons.TcpsCertSecretLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: o.GetNamespace()}}}
+}
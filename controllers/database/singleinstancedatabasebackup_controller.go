@@ -0,0 +1,501 @@
+/*
+** Copyright (c) 2026 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+// SingleInstanceDatabaseBackupReconciler reconciles a SingleInstanceDatabaseBackup object
+type SingleInstanceDatabaseBackupReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Config   *rest.Config
+	Recorder record.EventRecorder
+}
+
+// Condition type reporting the outcome of the last backup
+const BackupSucceededCondition = "BackupSucceeded"
+
+var ErrBackupVolumeNotMounted error = errors.New("backup volume claim is not mounted in the database pod, recreate the pod after setting persistence.backupVolumeClaimName")
+
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabasebackups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabasebackups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=pods;pods/exec,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile takes RMAN backups of the referred SingleInstanceDatabase according to the backup schedule.
+// Backups run in the background inside the database pod and are polled until completion.
+func (r *SingleInstanceDatabaseBackupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("Reconcile", req.NamespacedName)
+
+	backup := &dbapi.SingleInstanceDatabaseBackup{}
+	if err := r.Get(ctx, req.NamespacedName, backup); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Resource not found")
+			return requeueN, nil
+		}
+		log.Error(err, err.Error())
+		return requeueY, err
+	}
+
+	if backup.Status.Status == "" {
+		backup.Status.Status = dbcommons.StatusPending
+		if err := r.Status().Update(ctx, backup); err != nil {
+			return requeueY, err
+		}
+	}
+
+	sidb := &dbapi.SingleInstanceDatabase{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: backup.Spec.SingleInstanceDatabaseRef}, sidb); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Recorder.Eventf(backup, corev1.EventTypeWarning, "DatabaseNotFound",
+				"SingleInstanceDatabase %s not found", backup.Spec.SingleInstanceDatabaseRef)
+			return requeueY, nil
+		}
+		return requeueY, err
+	}
+
+	// A backup started earlier is still being tracked
+	if backup.Status.RunningTag != "" {
+		return r.checkRunningBackup(backup, sidb, ctx, req)
+	}
+
+	now := time.Now()
+	due, next, err := backupScheduleState(backup, now)
+	if err != nil {
+		r.Recorder.Event(backup, corev1.EventTypeWarning, "InvalidSchedule", err.Error())
+		backup.Status.Status = dbcommons.StatusError
+		return requeueN, r.Status().Update(ctx, backup)
+	}
+	if !next.IsZero() {
+		backup.Status.NextScheduleTime = &metav1.Time{Time: next}
+	}
+	if !due || backup.Spec.Suspend {
+		if err := r.Status().Update(ctx, backup); err != nil {
+			return requeueY, err
+		}
+		if next.IsZero() {
+			return requeueN, nil
+		}
+		log.Info("Next backup scheduled", "time", next)
+		return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+	}
+
+	result, err := r.startBackup(backup, sidb, now, ctx, req)
+	if statusErr := r.Status().Update(ctx, backup); statusErr != nil {
+		return requeueY, statusErr
+	}
+	return result, err
+}
+
+// #############################################################################
+//
+//	Returns whether a backup is due and the time of the following one
+//
+// #############################################################################
+func backupScheduleState(b *dbapi.SingleInstanceDatabaseBackup, now time.Time) (bool, time.Time, error) {
+	if b.Spec.Schedule == "" {
+		// One time backup
+		return b.Status.LastScheduleTime == nil, time.Time{}, nil
+	}
+	schedule, err := dbcommons.ParseCronSchedule(b.Spec.Schedule)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	last := b.CreationTimestamp.Time
+	if b.Status.LastScheduleTime != nil {
+		last = b.Status.LastScheduleTime.Time
+	}
+	next := schedule.Next(last)
+	if next.After(now) {
+		return false, next, nil
+	}
+	// Missed runs are collapsed into a single backup
+	return true, schedule.Next(now), nil
+}
+
+// #############################################################################
+//
+//	Validate the database and destination, then launch RMAN in the database pod
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseBackupReconciler) startBackup(b *dbapi.SingleInstanceDatabaseBackup, sidb *dbapi.SingleInstanceDatabase,
+	now time.Time, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("startBackup", req.NamespacedName)
+
+	if sidb.Status.Status != dbcommons.StatusReady {
+		log.Info("Database not ready, waiting to take backup", "database", sidb.Name)
+		return requeueY, nil
+	}
+	if sidb.Status.ArchiveLog != "true" {
+		r.Recorder.Eventf(b, corev1.EventTypeWarning, "ArchiveLogDisabled",
+			"Online backups require archiveLog to be enabled on %s", sidb.Name)
+		return requeueY, nil
+	}
+	readyPod, err := GetDatabaseReadyPod(r, sidb, ctx, req)
+	if err != nil {
+		return requeueY, err
+	}
+	if readyPod.Name == "" {
+		return requeueY, nil
+	}
+
+	channel, formatPrefix, err := r.prepareBackupDestination(b, sidb, readyPod, ctx, req)
+	if err != nil {
+		r.Recorder.Event(b, corev1.EventTypeWarning, "DestinationNotReady", err.Error())
+		return requeueY, nil
+	}
+
+	// Resources created before the CRD default was served have no backupType
+	if b.Spec.BackupType == "" {
+		b.Spec.BackupType = "full"
+	}
+	tag := fmt.Sprintf("%s_%s", strings.ToUpper(b.Spec.BackupType[:1]), now.UTC().Format("20060102T150405"))
+	script := buildRmanBackupScript(b, tag, channel, formatPrefix)
+	_, err = dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.RmanRunInBackgroundCMD, dbcommons.RmanLogLocation, tag, script))
+	if err != nil {
+		log.Error(err, err.Error())
+		r.Recorder.Eventf(b, corev1.EventTypeWarning, "BackupFailed", "Failed to start RMAN: %s", err.Error())
+		return requeueY, nil
+	}
+
+	b.Status.Sid = sidb.Status.Sid
+	b.Status.RunningTag = tag
+	b.Status.LastScheduleTime = &metav1.Time{Time: now}
+	b.Status.Status = dbcommons.StatusBackupRunning
+	b.Status.Backups = append(b.Status.Backups, dbapi.SingleInstanceDatabaseBackupRecord{
+		Tag:        tag,
+		BackupType: b.Spec.BackupType,
+		Status:     dbcommons.StatusBackupRunning,
+		StartTime:  &metav1.Time{Time: now},
+	})
+	r.Recorder.Eventf(b, corev1.EventTypeNormal, "BackupStarted", "RMAN %s backup %s started on %s", b.Spec.BackupType, tag, sidb.Name)
+	return requeueY, nil
+}
+
+// #############################################################################
+//
+//	Returns the RMAN channel and piece format prefix of the backup destination
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseBackupReconciler) prepareBackupDestination(b *dbapi.SingleInstanceDatabaseBackup, sidb *dbapi.SingleInstanceDatabase,
+	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) (string, string, error) {
+
+	if b.Spec.Destination.Type == "s3" {
+		if err := setupObjectStoreModule(r, r.Config, b, readyPod, ctx, req); err != nil {
+			return "", "", err
+		}
+		return fmt.Sprintf(dbcommons.RmanSbtChannelCMD, dbcommons.ObjectStoreLibLocation, b.Name), "", nil
+	}
+
+	if sidb.Spec.Persistence.BackupVolumeClaimName == "" {
+		return "", "", fmt.Errorf("persistence.backupVolumeClaimName is not set on %s", sidb.Name)
+	}
+	mounted := false
+	for _, v := range readyPod.Spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == sidb.Spec.Persistence.BackupVolumeClaimName {
+			mounted = true
+			break
+		}
+	}
+	if !mounted {
+		return "", "", ErrBackupVolumeNotMounted
+	}
+	location := dbcommons.BackupLocation + "/" + sidb.Status.Sid
+	if _, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		"mkdir -p "+location); err != nil {
+		return "", "", fmt.Errorf("unable to create backup location %s: %w", location, err)
+	}
+	return fmt.Sprintf(dbcommons.RmanDiskChannelCMD, location), location + "/", nil
+}
+
+// #############################################################################
+//
+//	Install the object storage backup module for the s3 destination of a backup
+//
+// #############################################################################
+func setupObjectStoreModule(r client.Reader, config *rest.Config, b *dbapi.SingleInstanceDatabaseBackup, pod corev1.Pod,
+	ctx context.Context, req ctrl.Request) error {
+
+	s3 := b.Spec.Destination.S3
+	if s3 == nil {
+		return errors.New("destination.s3 must be specified for s3 backups")
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: b.Namespace, Name: s3.CredentialsSecret}, secret); err != nil {
+		return fmt.Errorf("unable to get credentials secret %s: %w", s3.CredentialsSecret, err)
+	}
+	region := s3.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	// nologCommand as the command carries the access keys
+	_, err := dbcommons.ExecCommand(r, config, pod.Name, pod.Namespace, "", ctx, req, true, "bash", "-c",
+		fmt.Sprintf(dbcommons.ObjectStoreInstallCMD, dbcommons.ObjectStoreLibLocation, b.Name,
+			string(secret.Data["accessKeyId"]), string(secret.Data["secretAccessKey"]), s3.Endpoint, region, s3.Bucket))
+	if err != nil {
+		return fmt.Errorf("unable to install object storage backup module: %w", err)
+	}
+	return nil
+}
+
+// Builds the RMAN command file for the requested backup type
+func buildRmanBackupScript(b *dbapi.SingleInstanceDatabaseBackup, tag string, channel string, formatPrefix string) string {
+	var script []string
+	if b.Spec.Retention.RecoveryWindowDays > 0 {
+		script = append(script, fmt.Sprintf(dbcommons.RmanRetentionWindowCMD, b.Spec.Retention.RecoveryWindowDays))
+	} else if b.Spec.Retention.Redundancy > 0 {
+		script = append(script, fmt.Sprintf(dbcommons.RmanRetentionRedundancyCMD, b.Spec.Retention.Redundancy))
+	}
+	script = append(script, "RUN {", channel)
+
+	compression := ""
+	if b.Spec.Compressed {
+		compression = "AS COMPRESSED BACKUPSET"
+	}
+	switch b.Spec.BackupType {
+	case "archivelog":
+		script = append(script, fmt.Sprintf(dbcommons.RmanBackupArchivelogCMD, compression, tag))
+	case "incremental":
+		script = append(script, fmt.Sprintf(dbcommons.RmanBackupDatabaseCMD, compression, tag,
+			fmt.Sprintf("INCREMENTAL LEVEL %d", b.Spec.IncrementalLevel)))
+	default:
+		script = append(script, fmt.Sprintf(dbcommons.RmanBackupDatabaseCMD, compression, tag, ""))
+	}
+	script = append(script, fmt.Sprintf(dbcommons.RmanBackupControlfileCMD, tag, formatPrefix))
+	if b.Spec.Retention.RecoveryWindowDays > 0 || b.Spec.Retention.Redundancy > 0 {
+		script = append(script, dbcommons.RmanDeleteObsoleteCMD)
+	}
+	script = append(script, "}")
+	return strings.Join(script, "\n")
+}
+
+// #############################################################################
+//
+//	Poll the running RMAN job and record its pieces and SCN on completion
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseBackupReconciler) checkRunningBackup(b *dbapi.SingleInstanceDatabaseBackup, sidb *dbapi.SingleInstanceDatabase,
+	ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("checkRunningBackup", req.NamespacedName)
+
+	readyPod, err := GetDatabaseReadyPod(r, sidb, ctx, req)
+	if err != nil {
+		return requeueY, err
+	}
+	if readyPod.Name == "" {
+		log.Info("No ready database pod to check backup", "tag", b.Status.RunningTag)
+		return requeueY, nil
+	}
+
+	tag := b.Status.RunningTag
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.RmanJobStatusCMD, dbcommons.RmanLogLocation, tag))
+	if err != nil {
+		log.Error(err, err.Error())
+		return requeueY, nil
+	}
+	if strings.Contains(out, "rman_status:RUNNING") {
+		log.Info("RMAN backup in progress", "tag", tag)
+		return requeueY, nil
+	}
+
+	record := &dbapi.SingleInstanceDatabaseBackupRecord{Tag: tag}
+	for i := range b.Status.Backups {
+		if b.Status.Backups[i].Tag == tag {
+			record = &b.Status.Backups[i]
+		}
+	}
+	record.CompletionTime = &metav1.Time{Time: time.Now()}
+
+	if strings.Contains(out, "rman_status:COMPLETED") {
+		record.Status = dbcommons.StatusBackupCompleted
+		if err := r.recordBackupDetails(b, record, readyPod, ctx, req); err != nil {
+			log.Error(err, err.Error())
+		}
+		b.Status.LastSuccessfulTag = tag
+		meta.SetStatusCondition(&b.Status.Conditions, metav1.Condition{
+			Type:               BackupSucceededCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             dbcommons.StatusBackupCompleted,
+			Message:            fmt.Sprintf("Backup %s completed at SCN %s", tag, record.Scn),
+			ObservedGeneration: b.Generation,
+		})
+		r.Recorder.Eventf(b, corev1.EventTypeNormal, "BackupCompleted", "RMAN backup %s completed with %d pieces", tag, len(record.Pieces))
+	} else {
+		record.Status = dbcommons.StatusBackupFailed
+		meta.SetStatusCondition(&b.Status.Conditions, metav1.Condition{
+			Type:               BackupSucceededCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             dbcommons.StatusBackupFailed,
+			Message:            strings.TrimSpace(strings.Replace(out, "rman_status:FAILED", "", 1)),
+			ObservedGeneration: b.Generation,
+		})
+		r.Recorder.Eventf(b, corev1.EventTypeWarning, "BackupFailed", "RMAN backup %s failed, see %s/%s.log", tag, dbcommons.RmanLogLocation, tag)
+	}
+
+	r.pruneBackupRecords(b, readyPod, ctx, req)
+
+	b.Status.RunningTag = ""
+	b.Status.Status = dbcommons.StatusBackupScheduled
+	if b.Spec.Schedule == "" {
+		b.Status.Status = record.Status
+	}
+	if err := r.Status().Update(ctx, b); err != nil {
+		return requeueY, err
+	}
+	// Requeue to compute the next schedule
+	return requeueY, nil
+}
+
+// Fill pieces, control file piece and SCN of a completed backup
+func (r *SingleInstanceDatabaseBackupReconciler) recordBackupDetails(b *dbapi.SingleInstanceDatabaseBackup, record *dbapi.SingleInstanceDatabaseBackupRecord,
+	readyPod corev1.Pod, ctx context.Context, req ctrl.Request) error {
+
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e  \"%s\n%s\n%s\"  | %s", fmt.Sprintf(dbcommons.RmanBackupPiecesSQL, record.Tag),
+			fmt.Sprintf(dbcommons.RmanBackupScnSQL, record.Tag), dbcommons.GetDbIdSQL, dbcommons.SQLPlusCLI))
+	if err != nil {
+		return err
+	}
+	if strings.Contains(out, "ORA-") {
+		return fmt.Errorf("error while fetching backup details of %s\n%s", record.Tag, out)
+	}
+	record.Pieces = valuesWithPrefix(out, "piece:")
+	record.ControlFilePiece = ""
+	for _, piece := range record.Pieces {
+		if strings.Contains(piece, "_ctl_") {
+			record.ControlFilePiece = piece
+		}
+	}
+	if scn := valuesWithPrefix(out, "scn:"); len(scn) > 0 {
+		record.Scn = scn[0]
+	}
+	if dbid := valuesWithPrefix(out, "dbid:"); len(dbid) > 0 {
+		b.Status.DbId = dbid[0]
+	}
+	return nil
+}
+
+// #############################################################################
+//
+//	Drop records of backups deleted by the retention policy and trim history
+//
+// #############################################################################
+func (r *SingleInstanceDatabaseBackupReconciler) pruneBackupRecords(b *dbapi.SingleInstanceDatabaseBackup, readyPod corev1.Pod,
+	ctx context.Context, req ctrl.Request) {
+
+	log := r.Log.WithValues("pruneBackupRecords", req.NamespacedName)
+
+	out, err := dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf("echo -e  \"%s\"  | %s", dbcommons.RmanActiveBackupTagsSQL, dbcommons.SQLPlusCLI))
+	if err == nil && !strings.Contains(out, "ORA-") {
+		active := map[string]bool{}
+		for _, tag := range valuesWithPrefix(out, "tag:") {
+			active[tag] = true
+		}
+		var records []dbapi.SingleInstanceDatabaseBackupRecord
+		for _, rec := range b.Status.Backups {
+			if rec.Status == dbcommons.StatusBackupCompleted && !active[rec.Tag] {
+				log.Info("Backup removed by retention policy", "tag", rec.Tag)
+				continue
+			}
+			records = append(records, rec)
+		}
+		b.Status.Backups = records
+	}
+
+	limit := b.Spec.BackupHistoryLimit
+	if limit <= 0 {
+		limit = 10
+	}
+	if len(b.Status.Backups) > limit {
+		b.Status.Backups = b.Status.Backups[len(b.Status.Backups)-limit:]
+	}
+}
+
+// Returns the trimmed values of the lines starting with prefix
+func valuesWithPrefix(out string, prefix string) []string {
+	var values []string
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, prefix) {
+			if v := strings.TrimSpace(strings.TrimPrefix(line, prefix)); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *SingleInstanceDatabaseBackupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dbapi.SingleInstanceDatabaseBackup{}).
+		WithEventFilter(dbcommons.ResourceEventHandler()).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
+		Complete(r)
+}
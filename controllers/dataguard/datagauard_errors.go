@@ -45,3 +45,4 @@ import (
 var ErrSidbWithMutipleReplicas error = errors.New("SingleInstanceDatabase with multiple replicas is not supported")
 var ErrCurrentPrimaryDatabaseNotReady error = errors.New("current primary database not ready")
 var ErrCurrentPrimaryDatabaseNotFound error = errors.New("current primary database not found")
+var ErrDatabaseNotReady error = errors.New("database not ready")
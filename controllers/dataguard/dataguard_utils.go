@@ -42,6 +42,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -50,8 +53,10 @@ import (
 	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -65,6 +70,18 @@ func cleanupDataguardBroker(r *DataguardBrokerReconciler, broker *dbapi.Dataguar
 
 	log.Info(fmt.Sprintf("Cleaning for dataguard broker %v deletion", broker.Name))
 
+	// Remove the configuration through the external primary database, the singleinstancedatabases are all standbys
+	if broker.GetExternalDatabase(broker.Status.PrimaryDatabase) != nil {
+		out, err := execDgmgrlOnPrimary(r, broker, dbcommons.RemoveDataguardConfiguration, ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		log.Info("RemoveDataguardConfiguration Output")
+		log.Info(out)
+		return resetDgBrokerStatus(r, broker, ctx, req)
+	}
+
 	// Fetch Primary Database Reference
 	var sidb dbapi.SingleInstanceDatabase
 	if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: broker.GetCurrentPrimaryDatabase()}, &sidb); err != nil {
@@ -103,6 +120,13 @@ func cleanupDataguardBroker(r *DataguardBrokerReconciler, broker *dbapi.Dataguar
 	log.Info("RemoveDataguardConfiguration Output")
 	log.Info(out)
 
+	return resetDgBrokerStatus(r, broker, ctx, req)
+}
+
+// Set the dataguard broker status of the singleinstancedatabases in the configuration to nil
+func resetDgBrokerStatus(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+	log := ctrllog.FromContext(ctx).WithValues("cleanupDataguardBroker", req.NamespacedName)
+
 	for _, databaseRef := range broker.Status.DatabasesInDataguardConfig {
 
 		var standbyDatabase dbapi.SingleInstanceDatabase
@@ -145,8 +169,8 @@ func validateSidbReadiness(r *DataguardBrokerReconciler, broker *dbapi.Dataguard
 	}
 
 	// ## FETCH THE SIDB REPLICAS .
-	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, sidb.Spec.Image.Version,
-		sidb.Spec.Image.PullFrom, sidb.Name, sidb.Namespace, ctx, req)
+	// Any image, the primary keeps running the previous image during a standby-first patch
+	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", sidb.Name, sidb.Namespace, ctx, req)
 	if err != nil {
 		log.Error(err, err.Error())
 		return err
@@ -217,8 +241,7 @@ func setupDataguardBrokerConfiguration(r *DataguardBrokerReconciler, broker *dba
 	log := r.Log.WithValues("setupDataguardBrokerConfiguration", req.NamespacedName)
 
 	// Get sidb ready pod for current primary database
-	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, sidb.Spec.Image.Version,
-		sidb.Spec.Image.PullFrom, sidb.Name, sidb.Namespace, ctx, req)
+	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", sidb.Name, sidb.Namespace, ctx, req)
 	if err != nil {
 		log.Error(err, err.Error())
 		return err
@@ -375,7 +398,8 @@ func setupDataguardBrokerConfigurationForGivenDB(r *DataguardBrokerReconciler, m
 			}
 			log.Info("DgConfigurationMaxPerformance Output")
 			log.Info(out)
-		} else if m.Spec.ProtectionMode == "MaxAvailability" {
+		} else if m.Spec.ProtectionMode == "MaxAvailability" || m.Spec.ProtectionMode == "MaxProtection" {
+			// MaxProtection is set once the standby databases are added
 			// ## DG CONFIGURATION FOR PRIMARY DB || MODE : MAX AVAILABILITY ##
 			out, err := dbcommons.ExecCommand(r, r.Config, standbyDatabaseReadyPod.Name, standbyDatabaseReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
 				fmt.Sprintf(dbcommons.CreateDGMGRLScriptFile, dbcommons.DataguardBrokerMaxAvailabilityCMD))
@@ -468,7 +492,8 @@ func setupDataguardBrokerConfigurationForGivenDB(r *DataguardBrokerReconciler, m
 		log.Info("DgConfigurationMaxPerformance Output")
 		log.Info(out)
 
-	} else if m.Spec.ProtectionMode == "MaxAvailability" {
+	} else if m.Spec.ProtectionMode == "MaxAvailability" || m.Spec.ProtectionMode == "MaxProtection" {
+		// MaxProtection is set once the standby databases are added
 		// ## DG CONFIGURATION FOR PRIMARY DB || MODE : MAX AVAILABILITY ##
 		out, err := dbcommons.ExecCommand(r, r.Config, standbyDatabaseReadyPod.Name, standbyDatabaseReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
 			fmt.Sprintf(dbcommons.CreateDGMGRLScriptFile, dbcommons.DataguardBrokerAddDBMaxAvailabilityCMD))
@@ -518,6 +543,12 @@ func setupDataguardBrokerConfigurationForGivenDB(r *DataguardBrokerReconciler, m
 func patchService(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
 	log := r.Log.WithValues("patchService", req.NamespacedName)
 
+	// The service keeps pointing to the former primary database while an external database is the primary
+	if broker.GetExternalDatabase(broker.Status.PrimaryDatabase) != nil {
+		log.Info(fmt.Sprintf("Primary database %s is external, service not patched", broker.Status.PrimaryDatabase))
+		return nil
+	}
+
 	primaryDatabaseRef := broker.Status.DatabasesInDataguardConfig[broker.Status.PrimaryDatabase]
 	var svc *corev1.Service = &corev1.Service{}
 
@@ -582,18 +613,28 @@ func updateReconcileStatus(r *DataguardBrokerReconciler, broker *dbapi.Dataguard
 	for i := 0; i < len(databases); i++ {
 		splitstr := strings.Split(databases[i], ":")
 		database := strings.ToUpper(splitstr[0])
-		var singleInstanceDatabase dbapi.SingleInstanceDatabase
-		err := r.Get(ctx, types.NamespacedName{Name: broker.Status.DatabasesInDataguardConfig[database], Namespace: req.Namespace}, &singleInstanceDatabase)
-		if err != nil {
-			return err
+		// far sync instances are not backed by a singleinstancedatabase
+		if strings.HasPrefix(strings.ToUpper(splitstr[1]), "FAR_SYNC") {
+			continue
 		}
-		log.Info(fmt.Sprintf("Checking current role of %v is %v and its status is %v", broker.Status.DatabasesInDataguardConfig[database], strings.ToUpper(splitstr[1]), singleInstanceDatabase.Status.Role))
-		if singleInstanceDatabase.Status.Role != strings.ToUpper(splitstr[1]) {
-			singleInstanceDatabase.Status.Role = strings.ToUpper(splitstr[1])
-			r.Status().Update(ctx, &singleInstanceDatabase)
+		// external databases are not backed by a singleinstancedatabase
+		if broker.GetExternalDatabase(database) == nil {
+			var singleInstanceDatabase dbapi.SingleInstanceDatabase
+			err := r.Get(ctx, types.NamespacedName{Name: broker.Status.DatabasesInDataguardConfig[database], Namespace: req.Namespace}, &singleInstanceDatabase)
+			if err != nil {
+				return err
+			}
+			log.Info(fmt.Sprintf("Checking current role of %v is %v and its status is %v", broker.Status.DatabasesInDataguardConfig[database], strings.ToUpper(splitstr[1]), singleInstanceDatabase.Status.Role))
+			if singleInstanceDatabase.Status.Role != strings.ToUpper(splitstr[1]) {
+				singleInstanceDatabase.Status.Role = strings.ToUpper(splitstr[1])
+				r.Status().Update(ctx, &singleInstanceDatabase)
+			}
 		}
 		if strings.ToUpper(splitstr[1]) == "PRIMARY" && strings.ToUpper(database) != strings.ToUpper(broker.Status.PrimaryDatabase) {
 			log.Info("primary Database is " + strings.ToUpper(database))
+			if broker.Status.PrimaryDatabase != "" {
+				recordFailover(r, broker, broker.Status.PrimaryDatabase, strings.ToUpper(database), ctx)
+			}
 			broker.Status.PrimaryDatabase = strings.ToUpper(database)
 			// patch the service with the current primary
 		}
@@ -607,7 +648,6 @@ func updateReconcileStatus(r *DataguardBrokerReconciler, broker *dbapi.Dataguard
 	}
 
 	broker.Status.StandbyDatabases = standbyDatabases
-	broker.Status.ProtectionMode = broker.Spec.ProtectionMode
 	r.Status().Update(ctx, broker)
 
 	// patch the dataguardbroker resource service
@@ -718,7 +758,7 @@ func setFSFOTargets(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker,
 
 // #############################################################################
 //
-//	Setup the requested dataguard configuration
+//	Create, replace and remove the fast-start failover observer pods
 //
 // #############################################################################
 func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
@@ -741,20 +781,7 @@ func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBro
 		return err
 	}
 
-	// fetch the dataguardbroker observer replicas
-	_, brokerReplicasFound, _, _, err := dbcommons.FindPods(r, "", "", broker.Name, broker.Namespace, ctx, req)
-	if err != nil {
-		log.Error(err, err.Error())
-		return err
-	}
-
-	if brokerReplicasFound > 0 {
-		return nil
-	}
-
-	// Stop the already running observer
 	// find the avail pods for the currPrimaryDatabase
-	log.Info("Need to stop the observer if already running")
 	currPrimaryDatabaseReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", currPrimaryDatabase.Name, currPrimaryDatabase.Namespace, ctx, req)
 	if err != nil {
 		log.Error(err, err.Error())
@@ -780,22 +807,211 @@ func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBro
 	}
 	adminPassword := string(adminPasswordSecret.Data[currPrimaryDatabase.Spec.AdminPassword.SecretKey])
 
-	out, err := dbcommons.ExecCommand(r, r.Config, currPrimaryDatabaseReadyPod.Name, currPrimaryDatabaseReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
-		fmt.Sprintf("echo -e  \" STOP OBSERVER %s \"  | dgmgrl sys/%s@%s ", broker.Name, adminPassword, currPrimaryDatabase.Status.Sid))
+	dgmgrl := func(command string) (string, error) {
+		return dbcommons.ExecCommand(r, r.Config, currPrimaryDatabaseReadyPod.Name, currPrimaryDatabaseReadyPod.Namespace, "", ctx, req, true, "bash", "-c",
+			fmt.Sprintf("echo -e  \" %s \"  | dgmgrl sys/%s@%s ", command, adminPassword, currPrimaryDatabase.Status.Sid))
+	}
+
+	// fetch the dataguardbroker observer pods
+	observerNames := getObserverNames(broker)
+	observerPods := make(map[string]corev1.Pod)
+	observersChanged := false
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(broker.Namespace), client.MatchingLabels{"app": broker.Name}); err != nil {
+		log.Error(err, err.Error())
+		return err
+	}
+	for _, pod := range podList.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		observerName := broker.Name
+		if name, ok := pod.Labels[dbcommons.ObserverNameLabel]; ok {
+			observerName = name
+		}
+		_, duplicate := observerPods[observerName]
+		switch {
+		case pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded:
+			// Replace the failed observer
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Observer Failed", "observer %s in pod %s failed, replacing it", observerName, pod.Name)
+			log.Info("Deleting failed observer pod", "POD.NAME", pod.Name)
+		case !slices.Contains(observerNames, observerName) || duplicate:
+			// Remove observers over the requested count
+			out, err := dgmgrl(fmt.Sprintf(dbcommons.StopObserverCMD, observerName))
+			if err != nil {
+				log.Error(err, err.Error())
+				return err
+			}
+			log.Info(out)
+			r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Observer Removed", "observer %s stopped", observerName)
+			log.Info("Deleting observer pod", "POD.NAME", pod.Name)
+		default:
+			observerPods[observerName] = pod
+			continue
+		}
+		if err := r.Delete(ctx, &pod); err != nil && !apierrors.IsNotFound(err) {
+			log.Error(err, err.Error())
+			return err
+		}
+		observersChanged = true
+	}
+
+	for _, observerName := range observerNames {
+		if _, ok := observerPods[observerName]; ok {
+			continue
+		}
+
+		// Stop the observer if already registered in the broker by a previous pod
+		log.Info("Need to stop the observer if already running", "observer", observerName)
+		out, err := dgmgrl(fmt.Sprintf(dbcommons.StopObserverCMD, observerName))
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		log.Info(out)
+
+		pod := instantiateObserverPodSpec(broker, &currPrimaryDatabase, observerName)
+
+		// set the ownership and lifecyle of the observer pod to the dataguardbroker resource
+		ctrl.SetControllerReference(broker, &pod, r.Scheme)
+
+		log.Info("Creating a new  POD", "POD.Namespace", pod.Namespace, "POD.Name", pod.Name)
+		if err = r.Create(ctx, &pod); err != nil {
+			log.Error(err, "Failed to create new POD", "pod.Namespace", pod.Namespace, "POD.Name", pod.Name)
+			return err
+		}
+
+		// Waiting for Pod to get created as sometimes it takes some time to create a Pod . 30 seconds TImeout
+		timeout := 30
+		err = dbcommons.WaitForStatusChange(r, pod.Name, broker.Namespace, ctx, req, time.Duration(timeout)*time.Second, "pod", "creation")
+		if err != nil {
+			log.Error(err, "Error in Waiting for Pod status for Creation", "pod.Namespace", pod.Namespace, "POD.Name", pod.Name)
+			return err
+		}
+		log.Info("Succesfully Created New Pod ", "POD.NAME : ", pod.Name)
+		observerPods[observerName] = pod
+		observersChanged = true
+
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Observer Created", "observer %s created in pod %s", observerName, pod.Name)
+	}
+
+	// The broker is only queried when the observer pods differ from the status
+	if !observersChanged && observersInStatus(broker, observerNames, observerPods) {
+		return nil
+	}
+
+	// Read the master and backup observers registered in the broker
+	out, err := dgmgrl(dbcommons.ShowObserverCMD)
 	if err != nil {
 		log.Error(err, err.Error())
 		return err
 	}
-	log.Info(out)
-	// instantiate observer pod specification
-	pod := dbcommons.NewRealPodBuilder().
+	broker.Status.MasterObserver = ""
+	broker.Status.Observers = nil
+	for _, match := range observerRoleRegex.FindAllStringSubmatch(out, -1) {
+		if !slices.Contains(observerNames, match[1]) {
+			continue
+		}
+		if match[2] == "Master" {
+			broker.Status.MasterObserver = match[1]
+		}
+		broker.Status.Observers = append(broker.Status.Observers, dbapi.DataguardBrokerObserverStatus{
+			Name: match[1],
+			Pod:  observerPods[match[1]].Name,
+			Role: match[2],
+		})
+	}
+
+	// Hand the master role to a registered observer when the master is not one of the requested observers
+	if broker.Status.MasterObserver == "" && len(broker.Status.Observers) > 0 {
+		masterObserver := broker.Status.Observers[0].Name
+		out, err := dgmgrl(fmt.Sprintf(dbcommons.SetMasterObserverCMD, masterObserver))
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		log.Info(out)
+		broker.Status.MasterObserver = masterObserver
+		broker.Status.Observers[0].Role = "Master"
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Master Observer", "observer %s set as the master observer", masterObserver)
+	}
+
+	return nil
+}
+
+// Returns whether the status records a master observer and the current pod of every requested observer
+func observersInStatus(broker *dbapi.DataguardBroker, observerNames []string, observerPods map[string]corev1.Pod) bool {
+	if broker.Status.MasterObserver == "" || len(broker.Status.Observers) != len(observerNames) {
+		return false
+	}
+	for _, observer := range broker.Status.Observers {
+		pod, ok := observerPods[observer.Name]
+		if !ok || pod.Name != observer.Pod {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches the observers in the output of SHOW OBSERVER, e.g. Observer "dgbroker-sample"(19.3.0.0.0) - Master
+var observerRoleRegex = regexp.MustCompile(`Observer "([^"]+)".* - (Master|Backup)`)
+
+// #############################################################################
+//
+//	Returns the names of the requested observers
+//
+// #############################################################################
+func getObserverNames(broker *dbapi.DataguardBroker) []string {
+	// The first observer keeps the dataguardbroker name used by single observer configurations
+	observerNames := []string{broker.Name}
+	for i := 1; i < broker.Spec.Observers; i++ {
+		observerNames = append(observerNames, fmt.Sprintf("%s-%d", broker.Name, i))
+	}
+	return observerNames
+}
+
+// #############################################################################
+//
+//	Instantiate the observer pod specification
+//
+// #############################################################################
+func instantiateObserverPodSpec(broker *dbapi.DataguardBroker, currPrimaryDatabase *dbapi.SingleInstanceDatabase, observerName string) corev1.Pod {
+
+	// spread the observers of the dataguardbroker over the topology domains
+	podAffinityTerm := corev1.PodAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app": broker.Name},
+		},
+		TopologyKey: "kubernetes.io/hostname",
+	}
+	policy := "Preferred"
+	if placement := broker.Spec.ObserverPlacement; placement != nil {
+		if placement.TopologyKey != "" {
+			podAffinityTerm.TopologyKey = placement.TopologyKey
+		}
+		if placement.Policy != "" {
+			policy = placement.Policy
+		}
+	}
+	podAntiAffinity := &corev1.PodAntiAffinity{}
+	if policy == "Required" {
+		podAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = []corev1.PodAffinityTerm{podAffinityTerm}
+	} else {
+		podAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution = []corev1.WeightedPodAffinityTerm{{
+			Weight:          100,
+			PodAffinityTerm: podAffinityTerm,
+		}}
+	}
+
+	return dbcommons.NewRealPodBuilder().
 		SetNamespacedName(types.NamespacedName{
 			Name:      broker.Name + "-" + dbcommons.GenerateRandomString(5),
 			Namespace: broker.Namespace,
 		}).
 		SetLabels(map[string]string{
-			"app":     broker.Name,
-			"version": currPrimaryDatabase.Spec.Image.PullSecrets,
+			"app":                       broker.Name,
+			"version":                   currPrimaryDatabase.Spec.Image.PullSecrets,
+			dbcommons.ObserverNameLabel: observerName,
 		}).
 		SetTerminationGracePeriodSeconds(int64(30)).
 		SetNodeSelector(func() map[string]string {
@@ -807,6 +1023,7 @@ func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBro
 			}
 			return nsRule
 		}()).
+		SetAffinity(&corev1.Affinity{PodAntiAffinity: podAntiAffinity}).
 		SetSecurityContext(corev1.PodSecurityContext{
 			RunAsUser: func() *int64 { i := int64(54321); return &i }(),
 			FSGroup:   func() *int64 { i := int64(54321); return &i }(),
@@ -854,7 +1071,7 @@ func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBro
 				},
 				{
 					Name:  "DG_OBSERVER_NAME",
-					Value: broker.Name,
+					Value: observerName,
 				},
 				{
 					// Sid used here only for Locking mechanism to work .
@@ -875,29 +1092,217 @@ func createObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBro
 			},
 		}).
 		Build()
+}
+
+// #############################################################################
+//
+//	Delete the observer pods of the dataguardbroker
+//
+// #############################################################################
+func deleteObserverPods(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
 
-	// set the ownership and lifecyle of the observer pod to the dataguardbroker resource
-	ctrl.SetControllerReference(broker, &pod, r.Scheme)
+	log := r.Log.WithValues("deleteObserverPods", req.NamespacedName)
 
-	log.Info("Creating a new  POD", "POD.Namespace", pod.Namespace, "POD.Name", pod.Name)
-	if err = r.Create(ctx, &pod); err != nil {
-		log.Error(err, "Failed to create new POD", "pod.Namespace", pod.Namespace, "POD.Name", pod.Name)
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(broker.Namespace), client.MatchingLabels{"app": broker.Name}); err != nil {
+		log.Error(err, err.Error())
 		return err
 	}
+	for _, pod := range podList.Items {
+		if err := r.Delete(ctx, &pod); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	broker.Status.MasterObserver = ""
+	broker.Status.Observers = nil
+	return nil
+}
+
+// #############################################################################
+//
+//	Apply the protection mode and the broker properties of the standby databases
+//
+// #############################################################################
+func applyStandbySettings(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("applyStandbySettings", req.NamespacedName)
 
-	// Waiting for Pod to get created as sometimes it takes some time to create a Pod . 30 seconds TImeout
-	timeout := 30
-	err = dbcommons.WaitForStatusChange(r, pod.Name, broker.Namespace, ctx, req, time.Duration(timeout)*time.Second, "pod", "creation")
+	// The protection mode needs a standby database in the configuration
+	if len(broker.Status.DatabasesInDataguardConfig) < 2 {
+		return nil
+	}
+
+	applied := make(map[string]dbapi.DataguardBrokerStandbySettings)
+	for _, settings := range broker.Status.StandbySettings {
+		applied[settings.DatabaseRef] = settings
+	}
+	wanted := make(map[string]bool)
+	for _, settings := range broker.Spec.StandbySettings {
+		wanted[settings.DatabaseRef] = true
+	}
+	// Standby databases removed from the spec get their properties reset
+	desired := broker.Spec.StandbySettings
+	for _, settings := range broker.Status.StandbySettings {
+		if !wanted[settings.DatabaseRef] {
+			desired = append(desired, dbapi.DataguardBrokerStandbySettings{DatabaseRef: settings.DatabaseRef})
+		}
+	}
+
+	var commands []string
+	var inSync []dbapi.DataguardBrokerStandbySettings
+	for _, settings := range desired {
+		previous, found := applied[settings.DatabaseRef]
+		if reflect.DeepEqual(previous, settings) {
+			inSync = append(inSync, settings)
+			continue
+		}
+		var standbyDatabase dbapi.SingleInstanceDatabase
+		if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: settings.DatabaseRef}, &standbyDatabase); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		// Wait for the standby database to be added to the configuration
+		if standbyDatabase.Status.DgBroker == nil {
+			if found {
+				inSync = append(inSync, previous)
+			}
+			continue
+		}
+		sid := strings.ToUpper(standbyDatabase.Status.Sid)
+		if settings.LogXptMode != "" {
+			commands = append(commands, fmt.Sprintf(dbcommons.EditDatabasePropertyCMD, sid, "LogXptMode", "'"+settings.LogXptMode+"'"))
+		} else if previous.LogXptMode != "" {
+			commands = append(commands, fmt.Sprintf(dbcommons.ResetDatabasePropertyCMD, sid, "LogXptMode"))
+		}
+		if settings.DelayMins != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.EditDatabasePropertyCMD, sid, "DelayMins", strconv.Itoa(*settings.DelayMins)))
+		} else if previous.DelayMins != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.ResetDatabasePropertyCMD, sid, "DelayMins"))
+		}
+		if settings.ApplyLagThreshold != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.EditDatabasePropertyCMD, sid, "ApplyLagThreshold", strconv.Itoa(*settings.ApplyLagThreshold)))
+		} else if previous.ApplyLagThreshold != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.ResetDatabasePropertyCMD, sid, "ApplyLagThreshold"))
+		}
+		if settings.TransportLagThreshold != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.EditDatabasePropertyCMD, sid, "TransportLagThreshold", strconv.Itoa(*settings.TransportLagThreshold)))
+		} else if previous.TransportLagThreshold != nil {
+			commands = append(commands, fmt.Sprintf(dbcommons.ResetDatabasePropertyCMD, sid, "TransportLagThreshold"))
+		}
+		if wanted[settings.DatabaseRef] {
+			inSync = append(inSync, settings)
+		}
+	}
+
+	// Redo transport is set before the protection mode that depends on it
+	if broker.Status.ProtectionMode != broker.Spec.ProtectionMode {
+		commands = append(commands, fmt.Sprintf(dbcommons.SetProtectionModeCMD, strings.ToUpper(broker.Spec.ProtectionMode)))
+	}
+
+	if len(commands) > 0 {
+		out, err := execDgmgrlOnPrimary(r, broker, strings.Join(commands, "\n"), ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		log.Info("ApplyStandbySettings Output")
+		log.Info(out)
+		// Report the protection mode the broker ended up in, also when a command failed
+		if protectionMode := getProtectionMode(r, broker, ctx, req); protectionMode != "" {
+			broker.Status.ProtectionMode = protectionMode
+		}
+		if strings.Contains(out, "ORA-") {
+			r.Recorder.Event(broker, corev1.EventTypeWarning, "Standby Settings Failed", strings.TrimSpace(out))
+			return fmt.Errorf("applying the standby settings failed: %s", strings.TrimSpace(out))
+		}
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Standby Settings Applied", "protection mode %s and standby database properties applied", broker.Spec.ProtectionMode)
+	}
+
+	broker.Status.StandbySettings = inSync
+	return nil
+}
+
+// Matches the protection mode in the output of SHOW CONFIGURATION, e.g. Protection Mode: MaxAvailability
+var protectionModeRegex = regexp.MustCompile(`Protection Mode:\s*(MaxPerformance|MaxAvailability|MaxProtection)`)
+
+// #############################################################################
+//
+//	Returns the protection mode of the configuration, empty when the broker cannot be queried
+//
+// #############################################################################
+func getProtectionMode(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) string {
+	out, err := execDgmgrlOnPrimary(r, broker, dbcommons.DBShowConfigCMD, ctx, req)
 	if err != nil {
-		log.Error(err, "Error in Waiting for Pod status for Creation", "pod.Namespace", pod.Namespace, "POD.Name", pod.Name)
-		return err
+		r.Log.Info("Unable to read the protection mode", "error", err.Error())
+		return ""
+	}
+	if match := protectionModeRegex.FindStringSubmatch(out); match != nil {
+		return match[1]
 	}
-	log.Info("Succesfully Created New Pod ", "POD.NAME : ", pod.Name)
+	return ""
+}
 
-	eventReason := "SUCCESS"
-	eventMsg := ""
-	r.Recorder.Eventf(broker, corev1.EventTypeNormal, eventReason, eventMsg)
+// #############################################################################
+//
+//	Run DGMGRL commands connected to the given database of the configuration
+//
+// #############################################################################
+func execDgmgrlOnDatabase(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, databaseSid string, commands string, ctx context.Context, req ctrl.Request) (string, error) {
 
+	if externalDatabase := broker.GetExternalDatabase(databaseSid); externalDatabase != nil {
+		return execDgmgrlOnExternalDatabase(r, broker, externalDatabase, commands, ctx, req)
+	}
+
+	var database dbapi.SingleInstanceDatabase
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: broker.Status.DatabasesInDataguardConfig[databaseSid]}, &database); err != nil {
+		return "", err
+	}
+	readyPod, _, _, _, err := dbcommons.FindPods(r, "", "", database.Name, database.Namespace, ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if readyPod.Name == "" {
+		return "", fmt.Errorf("%w: %s", ErrDatabaseNotReady, database.Name)
+	}
+	var adminPasswordSecret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: database.Spec.AdminPassword.SecretName, Namespace: database.Namespace}, &adminPasswordSecret); err != nil {
+		return "", err
+	}
+	adminPassword := string(adminPasswordSecret.Data[database.Spec.AdminPassword.SecretKey])
+
+	return dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, true, "bash", "-c",
+		fmt.Sprintf("echo -e  \" %s \"  | dgmgrl sys/%s@%s ", commands, adminPassword, database.Status.Sid))
+}
+
+// #############################################################################
+//
+//	Create a chk file on the given databases of this cluster so that no other pods take the lock during a role change
+//
+// #############################################################################
+func createChkFiles(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, databaseSids []string, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("createChkFiles", req.NamespacedName)
+
+	for _, databaseSid := range databaseSids {
+		databaseRef, ok := broker.Status.DatabasesInDataguardConfig[databaseSid]
+		if !ok {
+			continue
+		}
+		sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", databaseRef, broker.Namespace, ctx, req)
+		if err != nil {
+			return err
+		}
+		if sidbReadyPod.Name == "" {
+			return fmt.Errorf("%w: %s", ErrDatabaseNotReady, databaseRef)
+		}
+		out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, false, "bash", "-c", dbcommons.CreateChkFileCMD)
+		if err != nil {
+			return err
+		}
+		log.Info("Successfully Created chk file " + out)
+	}
 	return nil
 }
 
@@ -1004,7 +1409,7 @@ func disableFSFOForDGConfig(r *DataguardBrokerReconciler, broker *dbapi.Dataguar
 	log.Info(fmt.Sprintf("Disabling FastStartFailover for the dataguard broker %s", broker.Name))
 
 	out, err := dbcommons.ExecCommand(r, r.Config, sidbReadyPod.Name, sidbReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
-		fmt.Sprintf("echo -e  \"%s\"  | dgmgrl sys/%s@%s ", fmt.Sprintf(dbcommons.DisableFSFOCMD, broker.Name), adminPassword, sidb.Status.Sid))
+		fmt.Sprintf("echo -e  \"%s\"  | dgmgrl sys/%s@%s ", fmt.Sprintf(dbcommons.DisableFSFOCMD, "ALL"), adminPassword, sidb.Status.Sid))
 	if err != nil {
 		r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Disabling FastStartFailover failed", fmt.Sprintf("Disabling FastStartFailover for the dataguard broker %s failed", broker.Name))
 		log.Error(err, err.Error())
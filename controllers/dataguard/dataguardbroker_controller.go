@@ -50,6 +50,7 @@ import (
 	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
@@ -149,7 +150,7 @@ func (r *DataguardBrokerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{Requeue: false}, err
 		}
 
-		// create Observer Pod
+		// create, replace and remove Observer Pods
 		if err := createObserverPods(r, &dataguardBroker, ctx, req); err != nil {
 			return ctrl.Result{Requeue: false}, err
 		}
@@ -164,27 +165,37 @@ func (r *DataguardBrokerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{Requeue: false}, err
 		}
 
-		// delete Observer Pod
-		observerReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", dataguardBroker.Name, dataguardBroker.Namespace, ctx, req)
-		if err != nil {
+		// delete Observer Pods
+		if err := deleteObserverPods(r, &dataguardBroker, ctx, req); err != nil {
 			return ctrl.Result{Requeue: false}, err
 		}
-		if observerReadyPod.Name != "" {
-			if err := r.Delete(ctx, &observerReadyPod); err != nil {
-				return ctrl.Result{Requeue: false}, err
-			}
-		}
 
-		r.Recorder.Eventf(&dataguardBroker, corev1.EventTypeNormal, "Observer Deleted", "database observer pod deleted")
+		r.Recorder.Eventf(&dataguardBroker, corev1.EventTypeNormal, "Observer Deleted", "database observer pods deleted")
 		log.Info("database observer deleted")
 
 		// set faststartfailover status to false
 		dataguardBroker.Status.FastStartFailover = "false"
 	}
 
+	// apply the protection mode and the standby database properties
+	if err := applyStandbySettings(r, &dataguardBroker, ctx, req); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+
+	// route the redo of the primary database through the far sync
+	if err := r.manageFarSync(&dataguardBroker, ctx, req); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+
+	// reinstate the former primary database after a failover
+	if err := r.manageReinstatement(&dataguardBroker, ctx, req); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+
 	// manage manual switchover
 	if dataguardBroker.Spec.SetAsPrimaryDatabase != "" && dataguardBroker.Spec.SetAsPrimaryDatabase != dataguardBroker.Status.PrimaryDatabase {
-		if _, ok := dataguardBroker.Status.DatabasesInDataguardConfig[dataguardBroker.Spec.SetAsPrimaryDatabase]; !ok {
+		if _, ok := dataguardBroker.Status.DatabasesInDataguardConfig[dataguardBroker.Spec.SetAsPrimaryDatabase]; !ok &&
+			dataguardBroker.GetExternalDatabase(dataguardBroker.Spec.SetAsPrimaryDatabase) == nil {
 			r.Recorder.Eventf(&dataguardBroker, corev1.EventTypeWarning, "Cannot Switchover", fmt.Sprintf("database with SID %v not found in dataguardbroker configuration", dataguardBroker.Spec.SetAsPrimaryDatabase))
 			log.Info(fmt.Sprintf("cannot perform switchover, database with SID %v not found in dataguardbroker configuration", dataguardBroker.Spec.SetAsPrimaryDatabase))
 			return ctrl.Result{Requeue: false}, nil
@@ -205,14 +216,32 @@ func (r *DataguardBrokerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, err
 	}
 
+	// Refresh the lag of the standby databases and the data guard metrics
+	healthCheckInterval := time.Duration(dataguardBroker.Spec.HealthCheckInterval) * time.Second
+	if healthCheckInterval > 0 {
+		if err := r.updateDataguardHealth(&dataguardBroker, ctx, req); err != nil {
+			log.Error(err, "dataguard health check failed")
+		}
+	} else if dataguardBroker.Status.LastHealthCheck != nil {
+		dataguardBroker.Status.StandbyHealth = nil
+		dataguardBroker.Status.ConfigurationStatus = ""
+		dataguardBroker.Status.LastHealthCheck = nil
+		deleteDataguardMetrics(&dataguardBroker)
+	}
+
 	dataguardBroker.Status.Status = dbcommons.StatusReady
 	log.Info("Reconcile Completed")
 
-	if dataguardBroker.Spec.FastStartFailover {
-		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
-	} else {
+	requeueAfter := healthCheckInterval
+	if (dataguardBroker.Spec.FastStartFailover ||
+		meta.IsStatusConditionTrue(dataguardBroker.Status.Conditions, dbcommons.ConditionReinstateRequired)) &&
+		(requeueAfter <= 0 || requeueAfter > 30*time.Second) {
+		requeueAfter = 30 * time.Second
+	}
+	if requeueAfter <= 0 {
 		return ctrl.Result{Requeue: false}, nil
 	}
+	return ctrl.Result{Requeue: true, RequeueAfter: requeueAfter}, nil
 }
 
 // #############################################################################################################################
@@ -235,6 +264,7 @@ func (r *DataguardBrokerReconciler) manageDataguardBrokerDeletion(broker *dbapi.
 			// handle the errors
 			return ctrl.Result{Requeue: false}, err
 		}
+		deleteDataguardMetrics(broker)
 
 		// Remove dataguardBrokerFinalizer. Once all finalizers have been
 		// removed, the object will be deleted.
@@ -361,6 +391,14 @@ func (r *DataguardBrokerReconciler) manageDataguardBrokerCreation(broker *dbapi.
 		}
 	}
 
+	// An external primary database is not backed by a singleinstancedatabase
+	if broker.GetExternalDatabase(broker.Status.PrimaryDatabase) != nil {
+		if err := updateReconcileStatus(r, broker, ctx, req); err != nil {
+			return ctrl.Result{Requeue: false}, err
+		}
+		return ctrl.Result{Requeue: false}, nil
+	}
+
 	// Get the current primary singleinstancedatabase resourcce
 	var sidb dbapi.SingleInstanceDatabase
 	namespacedName := types.NamespacedName{
@@ -409,6 +447,11 @@ func (r *DataguardBrokerReconciler) manageDataguardBrokerCreation(broker *dbapi.
 		return ctrl.Result{Requeue: false}, err
 	}
 
+	// add the databases hosted outside of this cluster
+	if err := addExternalDatabases(r, broker, ctx, req); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+
 	return ctrl.Result{Requeue: false}, nil
 }
 
@@ -421,6 +464,10 @@ func (r *DataguardBrokerReconciler) manageManualSwitchOver(targetSidbSid string,
 
 	log := r.Log.WithValues("SetAsPrimaryDatabase", req.NamespacedName)
 
+	if broker.GetExternalDatabase(targetSidbSid) != nil || broker.GetExternalDatabase(broker.Status.PrimaryDatabase) != nil {
+		return r.manageExternalSwitchOver(targetSidbSid, broker, ctx, req)
+	}
+
 	if _, ok := broker.Status.DatabasesInDataguardConfig[targetSidbSid]; !ok {
 		eventReason := "Cannot Switchover"
 		eventMsg := fmt.Sprintf("Database %s not a part of the dataguard configuration", targetSidbSid)
@@ -438,8 +485,8 @@ func (r *DataguardBrokerReconciler) manageManualSwitchOver(targetSidbSid string,
 	}
 
 	// Fetch the primary database ready pod to create chk file
-	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, sidb.Spec.Image.Version,
-		sidb.Spec.Image.PullFrom, sidb.Name, sidb.Namespace, ctx, req)
+	// Any image, the primary keeps running the previous image during a standby-first patch
+	sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", sidb.Name, sidb.Namespace, ctx, req)
 	if err != nil {
 		return ctrl.Result{Requeue: false}, err
 	}
@@ -0,0 +1,166 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// #############################################################################################################################
+//
+//	Add the external databases of the spec to the dataguard configuration
+//
+// #############################################################################################################################
+func addExternalDatabases(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("addExternalDatabases", req.NamespacedName)
+
+	// The broker adds the standby databases with ASYNC redo transport in MaxPerformance mode only
+	logXptMode := "SYNC"
+	if broker.Spec.ProtectionMode == "MaxPerformance" {
+		logXptMode = "ASYNC"
+	}
+
+	for _, externalDatabase := range broker.Spec.ExternalDatabases {
+		sid := strings.ToUpper(externalDatabase.Sid)
+		if slices.Contains(broker.Status.ExternalDatabases, sid) {
+			continue
+		}
+		if _, ok := broker.Status.DatabasesInDataguardConfig[sid]; ok {
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Spec Error", "A database with the same SID %s is already configured in the DG", sid)
+			continue
+		}
+
+		log.Info(fmt.Sprintf("adding external database %v", sid))
+		out, err := execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.AddExternalDatabaseCMD, sid, externalDatabase.ConnectString, logXptMode), ctx, req)
+		if errors.Is(err, ErrCurrentPrimaryDatabaseNotReady) {
+			log.Info("No ready pod avail for the current primary database")
+			return nil
+		}
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		if strings.Contains(out, "ORA-") {
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "External Database Not Added", "external database %s could not be added to the dataguard configuration", sid)
+			log.Info(out)
+			continue
+		}
+		broker.Status.ExternalDatabases = append(broker.Status.ExternalDatabases, sid)
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "External Database Added", "external database %s added to the dataguard configuration", sid)
+	}
+	return nil
+}
+
+// #############################################################################################################################
+//
+//	Run DGMGRL commands against an external database from a ready pod of the dataguard configuration
+//
+// #############################################################################################################################
+func execDgmgrlOnExternalDatabase(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, externalDatabase *dbapi.DataguardBrokerExternalDatabase,
+	commands string, ctx context.Context, req ctrl.Request) (string, error) {
+
+	var readyPod corev1.Pod
+	for _, databaseRef := range broker.Status.DatabasesInDataguardConfig {
+		sidbReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", databaseRef, broker.Namespace, ctx, req)
+		if err != nil {
+			return "", err
+		}
+		if sidbReadyPod.Name != "" {
+			readyPod = sidbReadyPod
+			break
+		}
+	}
+	if readyPod.Name == "" {
+		return "", ErrCurrentPrimaryDatabaseNotReady
+	}
+
+	var adminPasswordSecret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: externalDatabase.AdminPassword.SecretName, Namespace: broker.Namespace}, &adminPasswordSecret); err != nil {
+		return "", err
+	}
+	adminPassword := string(adminPasswordSecret.Data[externalDatabase.AdminPassword.SecretKey])
+
+	return dbcommons.ExecCommand(r, r.Config, readyPod.Name, readyPod.Namespace, "", ctx, req, true, "bash", "-c",
+		fmt.Sprintf("echo -e  \" %s \"  | dgmgrl sys/%s@%s ", commands, adminPassword, externalDatabase.ConnectString))
+}
+
+// #############################################################################################################################
+//
+//	Switchover to or from an external database
+//
+// #############################################################################################################################
+func (r *DataguardBrokerReconciler) manageExternalSwitchOver(targetSid string, broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("manageExternalSwitchOver", req.NamespacedName)
+
+	broker.Status.Status = dbcommons.StatusUpdating
+	r.Status().Update(ctx, broker)
+
+	// Create a chk file on the databases of this cluster so that no other pods take the lock during switchover
+	if err := createChkFiles(r, broker, []string{broker.Status.PrimaryDatabase, targetSid}, ctx, req); err != nil {
+		if errors.Is(err, ErrDatabaseNotReady) {
+			r.Recorder.Event(broker, corev1.EventTypeWarning, "Cannot Switchover", err.Error())
+			return ctrl.Result{Requeue: false}, nil
+		}
+		log.Error(err, err.Error())
+		return ctrl.Result{Requeue: false}, err
+	}
+
+	r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Waiting", "Switchover In Progress")
+	out, err := execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.SwitchoverCMD, targetSid), ctx, req)
+	if err != nil {
+		log.Error(err, err.Error())
+		return ctrl.Result{Requeue: false}, err
+	}
+	log.Info("SWITCHOVER TO " + targetSid + " Output")
+	log.Info(out)
+
+	return ctrl.Result{Requeue: false}, nil
+}
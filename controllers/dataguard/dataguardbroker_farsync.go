@@ -0,0 +1,364 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// #############################################################################################################################
+//
+//	Run the far sync instance of the dataguardbroker and route the redo of the primary database through it
+//
+// #############################################################################################################################
+func (r *DataguardBrokerReconciler) manageFarSync(broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("manageFarSync", req.NamespacedName)
+
+	farSync := broker.Spec.FarSync
+	if farSync == nil || (broker.Status.FarSync != nil && !strings.EqualFold(broker.Status.FarSync.Name, farSync.Name)) {
+		return r.removeFarSync(broker, ctx, req)
+	}
+	if broker.GetExternalDatabase(broker.Status.PrimaryDatabase) != nil {
+		log.Info("far sync is not managed while an external database is the primary")
+		return nil
+	}
+	farSyncSid := strings.ToUpper(farSync.Name)
+	resourceName := getFarSyncResourceName(broker.Name, farSync.Name)
+
+	var primaryDatabase dbapi.SingleInstanceDatabase
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: broker.GetCurrentPrimaryDatabase()}, &primaryDatabase); err != nil {
+		return err
+	}
+
+	// The service lets the primary and the standby databases reach the far sync instance
+	var svc corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: resourceName}, &svc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		svc = dbcommons.NewRealServiceBuilder().
+			SetName(resourceName).
+			SetNamespace(broker.Namespace).
+			SetLabels(map[string]string{
+				"app": resourceName,
+			}).
+			SetPorts([]corev1.ServicePort{
+				{
+					Name:     "listener",
+					Port:     1521,
+					Protocol: corev1.ProtocolTCP,
+				},
+			}).
+			SetSelector(map[string]string{
+				"app": resourceName,
+			}).
+			SetPublishNotReadyAddresses(true).
+			SetType(corev1.ServiceTypeClusterIP).
+			Build()
+		ctrl.SetControllerReference(broker, &svc, r.Scheme)
+		if err := r.Create(ctx, &svc); err != nil {
+			return err
+		}
+		log.Info("far sync service created", "service", svc.Name)
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.List(ctx, podList, client.InNamespace(broker.Namespace), client.MatchingLabels{"app": resourceName}); err != nil {
+		return err
+	}
+	var farSyncPod *corev1.Pod
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded {
+			if err := r.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		farSyncPod = pod
+		break
+	}
+	if farSyncPod == nil {
+		pod := instantiateFarSyncPodSpec(broker, &primaryDatabase, resourceName)
+		ctrl.SetControllerReference(broker, &pod, r.Scheme)
+		if err := r.Create(ctx, &pod); err != nil {
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Far Sync Creation Failed", "far sync pod %s creation failed", pod.Name)
+			return err
+		}
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Far Sync Pod Created", "far sync pod %s created", pod.Name)
+		log.Info("far sync pod created", "pod", pod.Name)
+		return nil
+	}
+	if farSyncPod.Status.Phase != corev1.PodRunning {
+		log.Info("far sync pod is not running yet", "pod", farSyncPod.Name)
+		return nil
+	}
+
+	// A new far sync pod starts without an instance, create it from the primary database
+	if broker.Status.FarSync == nil || broker.Status.FarSync.Pod != farSyncPod.Name {
+		primaryReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", primaryDatabase.Name, primaryDatabase.Namespace, ctx, req)
+		if err != nil {
+			return err
+		}
+		if primaryReadyPod.Name == "" {
+			log.Info("No ready pod avail for the current primary database")
+			return nil
+		}
+		var adminPasswordSecret corev1.Secret
+		if err := r.Get(ctx, types.NamespacedName{Name: primaryDatabase.Spec.AdminPassword.SecretName, Namespace: primaryDatabase.Namespace}, &adminPasswordSecret); err != nil {
+			return err
+		}
+		adminPassword := string(adminPasswordSecret.Data[primaryDatabase.Spec.AdminPassword.SecretKey])
+
+		out, err := dbcommons.ExecCommand(r, r.Config, primaryReadyPod.Name, primaryReadyPod.Namespace, "", ctx, req, false, "bash", "-c",
+			fmt.Sprintf("echo -e \"%s\" | %s", dbcommons.GetFarSyncParametersSQL, dbcommons.SQLPlusCLI))
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		params := map[string]string{}
+		for _, line := range strings.Split(out, "\n") {
+			if splitstr := strings.SplitN(strings.TrimSpace(line), ":", 3); len(splitstr) == 3 && splitstr[0] == "param" {
+				params[splitstr[1]] = splitstr[2]
+			}
+		}
+		if params["db_name"] == "" || params["compatible"] == "" {
+			return fmt.Errorf("cannot read the db_name and compatible parameters of the primary database: %s", out)
+		}
+
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Creating Far Sync", "creating far sync instance %s", farSyncSid)
+		out, err = dbcommons.ExecCommand(r, r.Config, farSyncPod.Name, farSyncPod.Namespace, "", ctx, req, true, "bash", "-c",
+			fmt.Sprintf(dbcommons.FarSyncCreateCMD, adminPassword, params["db_name"], params["compatible"],
+				primaryDatabase.Name+":1521/"+primaryDatabase.Status.Sid))
+		if err != nil || !strings.Contains(out, "Finished Duplicate Db") {
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Far Sync Creation Failed", "far sync instance %s creation failed", farSyncSid)
+			if err == nil {
+				err = errors.New("far sync instance creation failed")
+			}
+			log.Error(err, out)
+			return err
+		}
+
+		// The far sync stays in the broker configuration when its pod is recreated
+		commands := fmt.Sprintf(dbcommons.EnableFarSyncCMD, farSyncSid)
+		if broker.Status.FarSync == nil {
+			commands = fmt.Sprintf(dbcommons.AddFarSyncCMD, farSyncSid, resourceName) + "\\n" + commands
+		}
+		out, err = execDgmgrlOnPrimary(r, broker, commands, ctx, req)
+		if err != nil || strings.Contains(out, "ORA-") {
+			r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Far Sync Creation Failed", "far sync %s could not be added to the dataguard configuration", farSyncSid)
+			if err == nil {
+				err = errors.New("far sync could not be added to the dataguard configuration")
+			}
+			log.Error(err, out)
+			return err
+		}
+		broker.Status.FarSync = &dbapi.DataguardBrokerFarSyncStatus{Name: farSyncSid, Pod: farSyncPod.Name}
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Far Sync Created", "far sync %s added to the dataguard configuration", farSyncSid)
+	}
+
+	// The primary ships SYNC redo to the far sync, which forwards it ASYNC to the routed standby databases
+	var routed, direct []string
+	for databaseSid, databaseRef := range broker.Status.DatabasesInDataguardConfig {
+		if databaseSid == broker.Status.PrimaryDatabase {
+			continue
+		}
+		if len(farSync.StandbyDatabaseRefs) == 0 || slices.Contains(farSync.StandbyDatabaseRefs, databaseRef) {
+			routed = append(routed, databaseSid+" ASYNC")
+		} else {
+			direct = append(direct, ", "+databaseSid)
+		}
+	}
+	if len(routed) == 0 {
+		log.Info("no standby database to route through the far sync")
+		return nil
+	}
+	sort.Strings(routed)
+	sort.Strings(direct)
+	redoRoutes := fmt.Sprintf("(%s : %s)", broker.Status.PrimaryDatabase, strings.Join(routed, ", "))
+	if broker.Status.FarSync.RedoRoutes == redoRoutes {
+		return nil
+	}
+	out, err := execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.SetRedoRoutesCMD, broker.Status.PrimaryDatabase, farSyncSid,
+		strings.Join(direct, ""), strings.Join(routed, ", ")), ctx, req)
+	if errors.Is(err, ErrCurrentPrimaryDatabaseNotReady) {
+		log.Info("No ready pod avail for the current primary database")
+		return nil
+	}
+	if err != nil || strings.Contains(out, "ORA-") {
+		r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Redo Routes Failed", "redo routes through far sync %s could not be set", farSyncSid)
+		if err == nil {
+			err = errors.New("redo routes could not be set")
+		}
+		log.Error(err, out)
+		return err
+	}
+	broker.Status.FarSync.RedoRoutes = redoRoutes
+	r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Redo Routes Updated", "far sync %s redo routes set to %s", farSyncSid, redoRoutes)
+	log.Info("redo routes updated", "redoRoutes", redoRoutes)
+	return nil
+}
+
+// #############################################################################################################################
+//
+//	Remove the far sync from the dataguard configuration and delete its pod and service
+//
+// #############################################################################################################################
+func (r *DataguardBrokerReconciler) removeFarSync(broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("removeFarSync", req.NamespacedName)
+
+	if broker.Status.FarSync == nil {
+		return nil
+	}
+
+	// The primary ships redo directly to the standby databases again once its RedoRoutes are cleared
+	out, err := execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.RemoveFarSyncCMD, broker.Status.PrimaryDatabase, broker.Status.FarSync.Name), ctx, req)
+	if errors.Is(err, ErrCurrentPrimaryDatabaseNotReady) {
+		log.Info("No ready pod avail for the current primary database")
+		return nil
+	}
+	if err != nil {
+		log.Error(err, err.Error())
+		return err
+	}
+	log.Info(out)
+
+	resourceName := getFarSyncResourceName(broker.Name, broker.Status.FarSync.Name)
+	if err := r.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(broker.Namespace), client.MatchingLabels{"app": resourceName}); err != nil {
+		return err
+	}
+	svc := &corev1.Service{}
+	svc.Name = resourceName
+	svc.Namespace = broker.Namespace
+	if err := r.Delete(ctx, svc); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Far Sync Removed", "far sync %s removed from the dataguard configuration", broker.Status.FarSync.Name)
+	broker.Status.FarSync = nil
+	return nil
+}
+
+// #############################################################################################################################
+//
+//	Instantiate the far sync pod spec, the instance keeps its files on an emptyDir volume
+//
+// #############################################################################################################################
+func instantiateFarSyncPodSpec(broker *dbapi.DataguardBroker, currPrimaryDatabase *dbapi.SingleInstanceDatabase, resourceName string) corev1.Pod {
+
+	return dbcommons.NewRealPodBuilder().
+		SetNamespacedName(types.NamespacedName{
+			Name:      resourceName + "-" + dbcommons.GenerateRandomString(5),
+			Namespace: broker.Namespace,
+		}).
+		SetLabels(map[string]string{
+			"app": resourceName,
+		}).
+		SetTerminationGracePeriodSeconds(int64(30)).
+		SetNodeSelector(func() map[string]string {
+			var nsRule map[string]string = map[string]string{}
+			for key, value := range broker.Spec.FarSync.NodeSelector {
+				nsRule[key] = value
+			}
+			return nsRule
+		}()).
+		SetSecurityContext(corev1.PodSecurityContext{
+			RunAsUser: func() *int64 { i := int64(54321); return &i }(),
+			FSGroup:   func() *int64 { i := int64(54321); return &i }(),
+		}).
+		SetImagePullSecrets(currPrimaryDatabase.Spec.Image.PullSecrets).
+		AppendVolumes(corev1.Volume{
+			Name: "datamount",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		}).
+		AppendContainers(corev1.Container{
+			Name:    "farsync",
+			Image:   currPrimaryDatabase.Spec.Image.PullFrom,
+			Command: []string{"/bin/bash", "-c", dbcommons.FarSyncStartCMD},
+			Lifecycle: &corev1.Lifecycle{
+				PreStop: &corev1.LifecycleHandler{
+					Exec: &corev1.ExecAction{
+						Command: []string{"/bin/sh", "-c", "/bin/echo -en 'shutdown abort;\n' | env ORACLE_SID=${ORACLE_SID^^} sqlplus -S / as sysdba"},
+					},
+				},
+			},
+			ImagePullPolicy: corev1.PullAlways,
+			Ports:           []corev1.ContainerPort{{ContainerPort: 1521}},
+			VolumeMounts: []corev1.VolumeMount{{
+				MountPath: "/opt/oracle/oradata",
+				Name:      "datamount",
+			}},
+			Env: []corev1.EnvVar{
+				{
+					Name:  "ORACLE_SID",
+					Value: strings.ToUpper(broker.Spec.FarSync.Name),
+				},
+				{
+					Name:  "TNS_ADMIN",
+					Value: "/opt/oracle/oradata/network",
+				},
+			},
+		}).
+		Build()
+}
+
+// Name of the pod label and service of the far sync instance
+func getFarSyncResourceName(brokerName string, farSyncName string) string {
+	return brokerName + "-" + strings.ToLower(farSyncName)
+}
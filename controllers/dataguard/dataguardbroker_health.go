@@ -0,0 +1,226 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// Data guard metrics served from the operator metrics endpoint
+// The status gauges report 0 for SUCCESS, 1 for WARNING and 2 for ERROR
+var (
+	dataguardTransportLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_dataguard_transport_lag_seconds",
+		Help: "Transport lag of the standby database reported by the data guard broker",
+	}, []string{"namespace", "dataguardbroker", "database"})
+
+	dataguardApplyLag = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_dataguard_apply_lag_seconds",
+		Help: "Apply lag of the standby database reported by the data guard broker",
+	}, []string{"namespace", "dataguardbroker", "database"})
+
+	dataguardDatabaseStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_dataguard_database_status",
+		Help: "Broker status of the standby database, 0 SUCCESS, 1 WARNING, 2 ERROR",
+	}, []string{"namespace", "dataguardbroker", "database"})
+
+	dataguardConfigurationStatus = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "oracle_dataguard_configuration_status",
+		Help: "Status of the data guard configuration, 0 SUCCESS, 1 WARNING, 2 ERROR",
+	}, []string{"namespace", "dataguardbroker"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(dataguardTransportLag, dataguardApplyLag, dataguardDatabaseStatus, dataguardConfigurationStatus)
+}
+
+var brokerStatusRegex = regexp.MustCompile(`(?m)^(?:Configuration|Database) Status:\s*\n\s*(SUCCESS|WARNING|ERROR)`)
+var brokerLagRegex = regexp.MustCompile(`(?m)^\s*(Transport|Apply) Lag:\s*(.*)$`)
+var brokerDurationRegex = regexp.MustCompile(`(\d+)\s+(day|hour|minute|second)`)
+
+// #############################################################################################################################
+//
+//	Check the health of the dataguard configuration and export the lag of the standby databases
+//
+// #############################################################################################################################
+func (r *DataguardBrokerReconciler) updateDataguardHealth(broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("updateDataguardHealth", req.NamespacedName)
+
+	out, err := execDgmgrlOnPrimary(r, broker, dbcommons.DBShowConfigCMD, ctx, req)
+	if errors.Is(err, ErrCurrentPrimaryDatabaseNotReady) {
+		log.Info("No ready pod avail for the current primary database")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	broker.Status.ConfigurationStatus = getBrokerStatus(out)
+
+	var standbyHealth []dbapi.DataguardBrokerStandbyHealth
+	var databaseSids []string
+	for databaseSid := range broker.Status.DatabasesInDataguardConfig {
+		databaseSids = append(databaseSids, databaseSid)
+	}
+	databaseSids = append(databaseSids, broker.Status.ExternalDatabases...)
+	for _, databaseSid := range databaseSids {
+		if databaseSid == broker.Status.PrimaryDatabase {
+			continue
+		}
+		out, err := execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.ShowDatabaseCMD, databaseSid), ctx, req)
+		if err != nil {
+			log.Error(err, "failed to check the health of the standby database", "database", databaseSid)
+			standbyHealth = append(standbyHealth, dbapi.DataguardBrokerStandbyHealth{
+				Database: databaseSid,
+				Status:   "ERROR",
+				Error:    err.Error(),
+			})
+			continue
+		}
+		health := dbapi.DataguardBrokerStandbyHealth{
+			Database: databaseSid,
+			Status:   getBrokerStatus(out),
+		}
+		for _, match := range brokerLagRegex.FindAllStringSubmatch(out, -1) {
+			lag, ok := parseBrokerLag(match[2])
+			if !ok {
+				continue
+			}
+			if match[1] == "Transport" {
+				health.TransportLagSeconds = &lag
+			} else {
+				health.ApplyLagSeconds = &lag
+			}
+		}
+		standbyHealth = append(standbyHealth, health)
+	}
+	sort.Slice(standbyHealth, func(i, j int) bool { return standbyHealth[i].Database < standbyHealth[j].Database })
+	broker.Status.StandbyHealth = standbyHealth
+	now := metav1.Now()
+	broker.Status.LastHealthCheck = &now
+
+	exportDataguardMetrics(broker)
+	log.Info("dataguard health updated", "configurationStatus", broker.Status.ConfigurationStatus)
+	return nil
+}
+
+// Replace the metrics of the dataguardbroker with its last health check
+func exportDataguardMetrics(broker *dbapi.DataguardBroker) {
+	deleteDataguardMetrics(broker)
+	if value, ok := brokerStatusValue(broker.Status.ConfigurationStatus); ok {
+		dataguardConfigurationStatus.WithLabelValues(broker.Namespace, broker.Name).Set(value)
+	}
+	for _, health := range broker.Status.StandbyHealth {
+		if health.TransportLagSeconds != nil {
+			dataguardTransportLag.WithLabelValues(broker.Namespace, broker.Name, health.Database).Set(float64(*health.TransportLagSeconds))
+		}
+		if health.ApplyLagSeconds != nil {
+			dataguardApplyLag.WithLabelValues(broker.Namespace, broker.Name, health.Database).Set(float64(*health.ApplyLagSeconds))
+		}
+		if value, ok := brokerStatusValue(health.Status); ok {
+			dataguardDatabaseStatus.WithLabelValues(broker.Namespace, broker.Name, health.Database).Set(value)
+		}
+	}
+}
+
+// Remove the metrics of a dataguardbroker
+func deleteDataguardMetrics(broker *dbapi.DataguardBroker) {
+	labels := prometheus.Labels{"namespace": broker.Namespace, "dataguardbroker": broker.Name}
+	dataguardTransportLag.DeletePartialMatch(labels)
+	dataguardApplyLag.DeletePartialMatch(labels)
+	dataguardDatabaseStatus.DeletePartialMatch(labels)
+	dataguardConfigurationStatus.DeletePartialMatch(labels)
+}
+
+// Status reported by the SHOW CONFIGURATION and SHOW DATABASE commands
+func getBrokerStatus(out string) string {
+	if match := brokerStatusRegex.FindStringSubmatch(out); match != nil {
+		return match[1]
+	}
+	return "UNKNOWN"
+}
+
+func brokerStatusValue(status string) (float64, bool) {
+	switch status {
+	case "SUCCESS":
+		return 0, true
+	case "WARNING":
+		return 1, true
+	case "ERROR":
+		return 2, true
+	}
+	return 0, false
+}
+
+// Parse lags like "1 minute 5 seconds (computed 1 second ago)", an unknown lag is not reported
+func parseBrokerLag(lag string) (int64, bool) {
+	lag, _, _ = strings.Cut(lag, "(")
+	matches := brokerDurationRegex.FindAllStringSubmatch(lag, -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+	var seconds int64
+	for _, match := range matches {
+		value, _ := strconv.ParseInt(match[1], 10, 64)
+		switch match[2] {
+		case "day":
+			seconds += value * 86400
+		case "hour":
+			seconds += value * 3600
+		case "minute":
+			seconds += value * 60
+		default:
+			seconds += value
+		}
+	}
+	return seconds, true
+}
@@ -0,0 +1,89 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import "testing"
+
+func TestParseBrokerLag(t *testing.T) {
+	tests := []struct {
+		name    string
+		lag     string
+		seconds int64
+		ok      bool
+	}{
+		{name: "zero", lag: "0 seconds (computed 1 second ago)", seconds: 0, ok: true},
+		{name: "seconds", lag: "42 seconds (computed 0 seconds ago)", seconds: 42, ok: true},
+		{name: "singular units", lag: "1 day 1 hour 1 minute 1 second", seconds: 90061, ok: true},
+		{name: "minutes and seconds", lag: "1 minute 5 seconds (computed 1 second ago)", seconds: 65, ok: true},
+		{name: "hours", lag: "2 hours 3 minutes", seconds: 7380, ok: true},
+		{name: "days", lag: "3 days", seconds: 259200, ok: true},
+		{name: "computed time ignored", lag: "10 seconds (computed 5 minutes ago)", seconds: 10, ok: true},
+		{name: "unknown", lag: "(unknown)", ok: false},
+		{name: "empty", lag: "", ok: false},
+		{name: "no unit", lag: "15", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seconds, ok := parseBrokerLag(tt.lag)
+			if ok != tt.ok || seconds != tt.seconds {
+				t.Errorf("parseBrokerLag(%q) = %d, %v, want %d, %v", tt.lag, seconds, ok, tt.seconds, tt.ok)
+			}
+		})
+	}
+}
+
+func TestGetBrokerStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		out    string
+		status string
+	}{
+		{name: "configuration success", out: "Fast-Start Failover:  Disabled\n\nConfiguration Status:\nSUCCESS   (status updated 30 seconds ago)\n", status: "SUCCESS"},
+		{name: "database warning", out: "  Apply Lag:          0 seconds\n\nDatabase Status:\nWARNING\n", status: "WARNING"},
+		{name: "database error", out: "Database Status:\n  ERROR\n", status: "ERROR"},
+		{name: "no status", out: "ORA-16532: Oracle Data Guard broker configuration does not exist", status: "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if status := getBrokerStatus(tt.out); status != tt.status {
+				t.Errorf("getBrokerStatus() = %q, want %q", status, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// #############################################################################################################################
+//
+//	Record a change of the primary database that was not requested by a switchover
+//
+// #############################################################################################################################
+func recordFailover(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, formerPrimary string, newPrimary string, ctx context.Context) {
+	if (broker.Spec.SetAsPrimaryDatabase != "" && strings.EqualFold(broker.Spec.SetAsPrimaryDatabase, newPrimary)) ||
+		switchedOverByOperation(r, broker, newPrimary, ctx) {
+		setDataguardBrokerCondition(broker, dbcommons.ConditionFailedOver, false, dbcommons.SwitchoverReason,
+			fmt.Sprintf("switched over from %s to %s", formerPrimary, newPrimary))
+		return
+	}
+
+	r.Recorder.Eventf(broker, corev1.EventTypeWarning, "Failover Detected", "primary database failed over from %s to %s", formerPrimary, newPrimary)
+	r.Log.Info("Failover detected", "formerPrimary", formerPrimary, "newPrimary", newPrimary)
+
+	// Refresh the transition time for every failover
+	meta.RemoveStatusCondition(&broker.Status.Conditions, dbcommons.ConditionFailedOver)
+	setDataguardBrokerCondition(broker, dbcommons.ConditionFailedOver, true, dbcommons.FailoverReason,
+		fmt.Sprintf("primary database failed over from %s to %s", formerPrimary, newPrimary))
+	setDataguardBrokerCondition(broker, dbcommons.ConditionReinstateRequired, true, dbcommons.ReinstatePendingReason,
+		fmt.Sprintf("database %s needs to be reinstated", formerPrimary))
+}
+
+// Returns true when a dataguardoperation switched the configuration over to the given database
+func switchedOverByOperation(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, newPrimary string, ctx context.Context) bool {
+	operationList := &dbapi.DataguardOperationList{}
+	if err := r.List(ctx, operationList, client.InNamespace(broker.Namespace)); err != nil {
+		return false
+	}
+	for _, operation := range operationList.Items {
+		if operation.Spec.DataguardBrokerRef != broker.Name || operation.Spec.Operation != "switchover" ||
+			!strings.EqualFold(operation.Spec.TargetDatabase, newPrimary) {
+			continue
+		}
+		if operation.Status.Status == dbcommons.StatusOperationRunning {
+			return true
+		}
+		if operation.Status.Status == dbcommons.StatusOperationSucceeded && operation.Status.CompletionTime != nil &&
+			time.Since(operation.Status.CompletionTime.Time) < 10*time.Minute {
+			return true
+		}
+	}
+	return false
+}
+
+// #############################################################################################################################
+//
+//	Reinstate the former primary database as a standby after a failover
+//
+// #############################################################################################################################
+func (r *DataguardBrokerReconciler) manageReinstatement(broker *dbapi.DataguardBroker, ctx context.Context, req ctrl.Request) error {
+
+	log := r.Log.WithValues("manageReinstatement", req.NamespacedName)
+
+	if !meta.IsStatusConditionTrue(broker.Status.Conditions, dbcommons.ConditionReinstateRequired) {
+		return nil
+	}
+
+	dgmgrl := func(command string) (string, error) {
+		return execDgmgrlOnPrimary(r, broker, command, ctx, req)
+	}
+
+	pending := false
+	for databaseSid, databaseRef := range broker.Status.DatabasesInDataguardConfig {
+		if databaseSid == broker.Status.PrimaryDatabase {
+			continue
+		}
+
+		// The broker reports ORA-16661 for a database that needs to be reinstated
+		out, err := dgmgrl(fmt.Sprintf(dbcommons.ShowDatabaseCMD, databaseSid))
+		if errors.Is(err, ErrCurrentPrimaryDatabaseNotReady) {
+			log.Info("No ready pod avail for the current primary database")
+			return nil
+		}
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		if !strings.Contains(out, "ORA-16661") {
+			continue
+		}
+		pending = true
+
+		var formerPrimary dbapi.SingleInstanceDatabase
+		if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: databaseRef}, &formerPrimary); err != nil {
+			return err
+		}
+
+		// Reinstatement flashes the database back to the failover point
+		if flashBack, _ := strconv.ParseBool(formerPrimary.Status.FlashBack); !flashBack {
+			eventMsg := fmt.Sprintf("database %s needs to be reinstated but flashback is not enabled, recreate it as a standby", databaseSid)
+			if setDataguardBrokerCondition(broker, dbcommons.ConditionReinstateRequired, true, dbcommons.FlashbackDisabledReason, eventMsg) {
+				r.Recorder.Event(broker, corev1.EventTypeWarning, "Cannot Reinstate", eventMsg)
+			}
+			log.Info(eventMsg)
+			continue
+		}
+
+		formerPrimaryPod, _, available, _, err := dbcommons.FindPods(r, "", "", formerPrimary.Name, formerPrimary.Namespace, ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		if formerPrimaryPod.Name == "" && len(available) > 0 {
+			formerPrimaryPod = available[0]
+		}
+		if formerPrimaryPod.Name == "" || formerPrimaryPod.Status.Phase != corev1.PodRunning {
+			log.Info("Waiting for a running pod of the former primary database", "database", formerPrimary.Name)
+			continue
+		}
+
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Reinstating", "reinstating database %s as a standby of %s", databaseSid, broker.Status.PrimaryDatabase)
+		log.Info("Reinstating database", "database", databaseSid)
+
+		out, err = reinstateDatabase(r, broker, &formerPrimaryPod, databaseSid, ctx, req)
+		if err != nil {
+			log.Error(err, err.Error())
+			return err
+		}
+		log.Info("ReinstateDatabase Output")
+		log.Info(out)
+
+		if strings.Contains(out, "ORA-") {
+			eventMsg := fmt.Sprintf("reinstatement of database %s failed: %s", databaseSid, strings.TrimSpace(out))
+			setDataguardBrokerCondition(broker, dbcommons.ConditionReinstateRequired, true, dbcommons.ReinstateFailedReason, eventMsg)
+			r.Recorder.Event(broker, corev1.EventTypeWarning, "Reinstate Failed", eventMsg)
+			continue
+		}
+
+		r.Recorder.Eventf(broker, corev1.EventTypeNormal, "Reinstated", "database %s reinstated as a standby of %s", databaseSid, broker.Status.PrimaryDatabase)
+	}
+
+	if pending {
+		return nil
+	}
+
+	setDataguardBrokerCondition(broker, dbcommons.ConditionReinstateRequired, false, dbcommons.ReinstatedReason,
+		"all databases in the dataguard configuration are enabled")
+	log.Info("No database needs to be reinstated")
+	return nil
+}
+
+// Mount the former primary database in its pod and reinstate it through the current primary database
+func reinstateDatabase(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, formerPrimaryPod *corev1.Pod, databaseSid string,
+	ctx context.Context, req ctrl.Request) (string, error) {
+
+	log := r.Log.WithValues("reinstateDatabase", req.NamespacedName)
+
+	// The former primary database has to be mounted to be reinstated
+	out, err := dbcommons.ExecCommand(r, r.Config, formerPrimaryPod.Name, formerPrimaryPod.Namespace, "", ctx, req, false, "bash", "-c",
+		fmt.Sprintf(dbcommons.MountFormerPrimaryCMD, dbcommons.SQLPlusCLI))
+	if err != nil {
+		return "", err
+	}
+	log.Info(out)
+
+	out, err = execDgmgrlOnPrimary(r, broker, fmt.Sprintf(dbcommons.ReinstateDatabaseCMD, databaseSid), ctx, req)
+	if _, rmErr := dbcommons.ExecCommand(r, r.Config, formerPrimaryPod.Name, formerPrimaryPod.Namespace, "", ctx, req, false, "bash", "-c",
+		dbcommons.RemoveChkFileCMD); rmErr != nil {
+		log.Error(rmErr, rmErr.Error())
+	}
+	return out, err
+}
+
+// Sets a condition of the dataguardbroker, returns true when the status or reason of the condition changed
+func setDataguardBrokerCondition(broker *dbapi.DataguardBroker, conditionType string, status bool, reason string, message string) bool {
+	condition := metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: broker.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		condition.Status = metav1.ConditionTrue
+	}
+	previous := meta.FindStatusCondition(broker.Status.Conditions, conditionType)
+	changed := previous == nil || previous.Status != condition.Status || previous.Reason != condition.Reason
+	meta.SetStatusCondition(&broker.Status.Conditions, condition)
+	return changed
+}
+
+// #############################################################################
+//
+//	Run dgmgrl commands connected to the current primary database
+//
+// #############################################################################
+func execDgmgrlOnPrimary(r *DataguardBrokerReconciler, broker *dbapi.DataguardBroker, commands string, ctx context.Context, req ctrl.Request) (string, error) {
+
+	if externalDatabase := broker.GetExternalDatabase(broker.Status.PrimaryDatabase); externalDatabase != nil {
+		return execDgmgrlOnExternalDatabase(r, broker, externalDatabase, commands, ctx, req)
+	}
+
+	var primaryDatabase dbapi.SingleInstanceDatabase
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: broker.GetCurrentPrimaryDatabase()}, &primaryDatabase); err != nil {
+		return "", err
+	}
+	primaryReadyPod, _, _, _, err := dbcommons.FindPods(r, "", "", primaryDatabase.Name, primaryDatabase.Namespace, ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if primaryReadyPod.Name == "" {
+		return "", ErrCurrentPrimaryDatabaseNotReady
+	}
+	var adminPasswordSecret corev1.Secret
+	if err := r.Get(ctx, types.NamespacedName{Name: primaryDatabase.Spec.AdminPassword.SecretName, Namespace: primaryDatabase.Namespace}, &adminPasswordSecret); err != nil {
+		return "", err
+	}
+	adminPassword := string(adminPasswordSecret.Data[primaryDatabase.Spec.AdminPassword.SecretKey])
+
+	return dbcommons.ExecCommand(r, r.Config, primaryReadyPod.Name, primaryReadyPod.Namespace, "", ctx, req, true, "bash", "-c",
+		fmt.Sprintf("echo -e  \" %s \"  | dgmgrl sys/%s@%s ", commands, adminPassword, primaryDatabase.Status.Sid))
+}
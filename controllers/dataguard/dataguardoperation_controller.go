@@ -0,0 +1,364 @@
+/*
+** Copyright (c) 2024 Oracle and/or its affiliates.
+**
+** The Universal Permissive License (UPL), Version 1.0
+**
+** Subject to the condition set forth below, permission is hereby granted to any
+** person obtaining a copy of this software, associated documentation and/or data
+** (collectively the "Software"), free of charge and under any and all copyright
+** rights in the Software, and any and all patent rights owned or freely
+** licensable by each licensor hereunder covering either (i) the unmodified
+** Software as contributed to or provided by such licensor, or (ii) the Larger
+** Works (as defined below), to deal in both
+**
+** (a) the Software, and
+** (b) any piece of software and/or hardware listed in the lrgrwrks.txt file if
+** one is included with the Software (each a "Larger Work" to which the Software
+** is contributed by such licensors),
+**
+** without restriction, including without limitation the rights to copy, create
+** derivative works of, display, perform, and distribute the Software and make,
+** use, sell, offer for sale, import, export, have made, and have sold the
+** Software and the Larger Work(s), and to sublicense the foregoing rights on
+** either these or other terms.
+**
+** This license is subject to the following condition:
+** The above copyright notice and either this complete permission notice or at
+** a minimum a reference to the UPL must be included in all copies or
+** substantial portions of the Software.
+**
+** THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+** IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+** FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+** AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+** LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+** OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+** SOFTWARE.
+ */
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	dbapi "github.com/oracle/oracle-database-operator/apis/database/v4"
+	dbcommons "github.com/oracle/oracle-database-operator/commons/database"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+)
+
+// DataguardOperationReconciler reconciles a DataguardOperation object
+type DataguardOperationReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Config   *rest.Config
+	Recorder record.EventRecorder
+
+	// Events of the dataguardbrokers are recorded under their own component
+	brokerRecorder record.EventRecorder
+}
+
+// Maximum length of the DGMGRL output kept in the operation status
+const dataguardOperationOutputLimit = 4096
+
+// Time given to a singleinstancedatabase to convert its role
+const dataguardOperationConvertTimeout = 30 * time.Minute
+
+var switchoverReadyRegex = regexp.MustCompile(`Ready for Switchover:\s*Yes`)
+var failoverReadyRegex = regexp.MustCompile(`Ready for Failover:\s*Yes`)
+
+//+kubebuilder:rbac:groups=database.oracle.com,resources=dataguardoperations,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=database.oracle.com,resources=dataguardoperations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=database.oracle.com,resources=dataguardbrokers,verbs=get;list;watch
+//+kubebuilder:rbac:groups=database.oracle.com,resources=singleinstancedatabases,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups="",resources=pods;pods/exec,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile runs the operation of a DataguardOperation once and records its outcome in the status
+func (r *DataguardOperationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+
+	log := r.Log.WithValues("reconciler", req.NamespacedName)
+
+	var operation dbapi.DataguardOperation
+	if err := r.Get(ctx, req.NamespacedName, &operation); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Resource deleted")
+			return ctrl.Result{Requeue: false}, nil
+		}
+		return ctrl.Result{Requeue: false}, err
+	}
+
+	// An operation runs only once
+	switch operation.Status.Status {
+	case dbcommons.StatusOperationSucceeded, dbcommons.StatusOperationFailed:
+		return ctrl.Result{Requeue: false}, nil
+	case "":
+		operation.Status.Status = dbcommons.StatusPending
+		if err := r.Status().Update(ctx, &operation); err != nil {
+			return ctrl.Result{Requeue: false}, err
+		}
+	}
+
+	var broker dbapi.DataguardBroker
+	if err := r.Get(ctx, types.NamespacedName{Namespace: req.Namespace, Name: operation.Spec.DataguardBrokerRef}, &broker); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.completeOperation(&operation, "", fmt.Errorf("dataguardbroker %s not found", operation.Spec.DataguardBrokerRef), ctx)
+		}
+		return ctrl.Result{Requeue: false}, err
+	}
+	brokerReconciler := &DataguardBrokerReconciler{Client: r.Client, Log: r.Log, Scheme: r.Scheme, Config: r.Config, Recorder: r.brokerRecorder}
+	targetSid := strings.ToUpper(operation.Spec.TargetDatabase)
+
+	if operation.Status.Status == dbcommons.StatusOperationRunning {
+		// Conversions of singleinstancedatabases are run by their own controller
+		if _, ok := broker.Status.DatabasesInDataguardConfig[targetSid]; ok && strings.HasPrefix(operation.Spec.Operation, "convert") {
+			return r.checkConversion(&operation, &broker, targetSid, ctx)
+		}
+		return r.completeOperation(&operation, operation.Status.Output,
+			errors.New("operation interrupted, check the state of the dataguard configuration"), ctx)
+	}
+
+	// Validate the operation against the dataguard configuration
+	if broker.Status.Status != dbcommons.StatusReady {
+		operation.Status.Message = fmt.Sprintf("waiting for dataguardbroker %s to be ready", broker.Name)
+		r.Status().Update(ctx, &operation)
+		return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+	}
+	_, isLocal := broker.Status.DatabasesInDataguardConfig[targetSid]
+	if !isLocal && broker.GetExternalDatabase(targetSid) == nil {
+		return r.completeOperation(&operation, "", fmt.Errorf("database %s is not a part of the dataguard configuration", targetSid), ctx)
+	}
+	if targetSid == broker.Status.PrimaryDatabase {
+		return r.completeOperation(&operation, "", fmt.Errorf("database %s is the primary database", targetSid), ctx)
+	}
+	// The dataguardbroker switches back to setAsPrimaryDatabase after a role change
+	if (operation.Spec.Operation == "switchover" || operation.Spec.Operation == "failover") &&
+		broker.Spec.SetAsPrimaryDatabase != "" && !strings.EqualFold(broker.Spec.SetAsPrimaryDatabase, targetSid) {
+		return r.completeOperation(&operation, "", fmt.Errorf("setAsPrimaryDatabase of dataguardbroker %s is set to %s, clear it first",
+			broker.Name, broker.Spec.SetAsPrimaryDatabase), ctx)
+	}
+
+	// Run one operation at a time on a dataguard configuration
+	operationList := &dbapi.DataguardOperationList{}
+	if err := r.List(ctx, operationList, client.InNamespace(req.Namespace)); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+	for _, other := range operationList.Items {
+		if other.Name != operation.Name && other.Spec.DataguardBrokerRef == broker.Name && other.Status.Status == dbcommons.StatusOperationRunning {
+			operation.Status.Message = fmt.Sprintf("waiting for dataguardoperation %s to complete", other.Name)
+			r.Status().Update(ctx, &operation)
+			return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+		}
+	}
+
+	now := metav1.Now()
+	operation.Status.Status = dbcommons.StatusOperationRunning
+	operation.Status.StartTime = &now
+	operation.Status.PrimaryDatabase = broker.Status.PrimaryDatabase
+	operation.Status.Message = ""
+	if err := r.Status().Update(ctx, &operation); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+	r.Recorder.Eventf(&operation, corev1.EventTypeNormal, "Operation Started", "%s of database %s started", operation.Spec.Operation, targetSid)
+
+	// Prechecks
+	if !operation.Spec.SkipPrechecks {
+		var out string
+		var err error
+		var ready bool
+		switch operation.Spec.Operation {
+		case "failover":
+			// The primary database may be down, validate through the target database
+			out, err = execDgmgrlOnDatabase(brokerReconciler, &broker, targetSid, fmt.Sprintf(dbcommons.ValidateDatabaseCMD, targetSid), ctx, req)
+			ready = failoverReadyRegex.MatchString(out)
+		case "reinstate":
+			// The broker reports ORA-16661 for a database that needs to be reinstated
+			out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.ShowDatabaseCMD, targetSid), ctx, req)
+			ready = strings.Contains(out, "ORA-16661")
+		case "switchover":
+			out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.ValidateDatabaseCMD, targetSid), ctx, req)
+			ready = switchoverReadyRegex.MatchString(out)
+		default:
+			out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.ValidateDatabaseCMD, targetSid), ctx, req)
+			ready = !strings.Contains(out, "ORA-")
+		}
+		operation.Status.PrecheckOutput = truncateOperationOutput(out)
+		if err != nil {
+			return r.completeOperation(&operation, "", fmt.Errorf("prechecks failed: %w", err), ctx)
+		}
+		if !ready {
+			return r.completeOperation(&operation, "", fmt.Errorf("prechecks failed, database %s is not ready for %s", targetSid, operation.Spec.Operation), ctx)
+		}
+	}
+
+	var out string
+	var err error
+	switch operation.Spec.Operation {
+	case "switchover":
+		if err = createChkFiles(brokerReconciler, &broker, []string{broker.Status.PrimaryDatabase, targetSid}, ctx, req); err == nil {
+			out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.SwitchoverCMD, targetSid), ctx, req)
+		}
+	case "failover":
+		immediate := ""
+		if operation.Spec.Immediate {
+			immediate = " IMMEDIATE"
+		}
+		if err = createChkFiles(brokerReconciler, &broker, []string{targetSid}, ctx, req); err == nil {
+			out, err = execDgmgrlOnDatabase(brokerReconciler, &broker, targetSid, fmt.Sprintf(dbcommons.FailoverCMD, targetSid, immediate), ctx, req)
+		}
+	case "convertToSnapshotStandby", "convertToPhysicalStandby":
+		if isLocal {
+			return r.requestConversion(&operation, &broker, targetSid, ctx)
+		}
+		role := "SNAPSHOT"
+		if operation.Spec.Operation == "convertToPhysicalStandby" {
+			role = "PHYSICAL"
+		}
+		out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.ConvertDatabaseCMD, targetSid, role), ctx, req)
+	case "reinstate":
+		if !isLocal {
+			out, err = execDgmgrlOnPrimary(brokerReconciler, &broker, fmt.Sprintf(dbcommons.ReinstateDatabaseCMD, targetSid), ctx, req)
+			break
+		}
+		formerPrimaryPod, _, available, _, findErr := dbcommons.FindPods(r, "", "", broker.Status.DatabasesInDataguardConfig[targetSid], req.Namespace, ctx, req)
+		if findErr != nil {
+			return ctrl.Result{Requeue: false}, findErr
+		}
+		if formerPrimaryPod.Name == "" && len(available) > 0 {
+			formerPrimaryPod = available[0]
+		}
+		if formerPrimaryPod.Name == "" || formerPrimaryPod.Status.Phase != corev1.PodRunning {
+			err = fmt.Errorf("%w: no running pod for %s", ErrDatabaseNotReady, broker.Status.DatabasesInDataguardConfig[targetSid])
+			break
+		}
+		out, err = reinstateDatabase(brokerReconciler, &broker, &formerPrimaryPod, targetSid, ctx, req)
+	}
+	return r.completeOperation(&operation, out, err, ctx)
+}
+
+// #############################################################################################################################
+//
+//	Record the outcome of the operation, a DGMGRL output with errors fails the operation
+//
+// #############################################################################################################################
+func (r *DataguardOperationReconciler) completeOperation(operation *dbapi.DataguardOperation, out string, err error, ctx context.Context) (ctrl.Result, error) {
+
+	if err == nil && (strings.Contains(out, "ORA-") || strings.Contains(out, "Error:")) {
+		err = errors.New("DGMGRL reported an error, see the output")
+	}
+
+	now := metav1.Now()
+	operation.Status.CompletionTime = &now
+	operation.Status.Output = truncateOperationOutput(out)
+	if err != nil {
+		operation.Status.Status = dbcommons.StatusOperationFailed
+		operation.Status.Message = err.Error()
+		r.Recorder.Eventf(operation, corev1.EventTypeWarning, "Operation Failed", "%s of database %s failed: %s",
+			operation.Spec.Operation, strings.ToUpper(operation.Spec.TargetDatabase), err.Error())
+	} else {
+		operation.Status.Status = dbcommons.StatusOperationSucceeded
+		operation.Status.Message = fmt.Sprintf("%s of database %s succeeded", operation.Spec.Operation, strings.ToUpper(operation.Spec.TargetDatabase))
+		r.Recorder.Event(operation, corev1.EventTypeNormal, "Operation Succeeded", operation.Status.Message)
+	}
+	r.Log.Info(operation.Status.Message, "operation", operation.Name)
+
+	if err := r.Status().Update(ctx, operation); err != nil {
+		return ctrl.Result{Requeue: false}, err
+	}
+	return ctrl.Result{Requeue: false}, nil
+}
+
+// #############################################################################################################################
+//
+//	Request the conversion of a singleinstancedatabase through its convert-to-snapshot-standby annotation
+//
+// #############################################################################################################################
+func (r *DataguardOperationReconciler) requestConversion(operation *dbapi.DataguardOperation, broker *dbapi.DataguardBroker, targetSid string,
+	ctx context.Context) (ctrl.Result, error) {
+
+	var sidb dbapi.SingleInstanceDatabase
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: broker.Status.DatabasesInDataguardConfig[targetSid]}, &sidb); err != nil {
+		return r.completeOperation(operation, "", err, ctx)
+	}
+	patch := client.MergeFrom(sidb.DeepCopy())
+	if sidb.Annotations == nil {
+		sidb.Annotations = make(map[string]string)
+	}
+	sidb.Annotations[dbcommons.ConvertToSnapshotStandbyAnnotation] = strconv.FormatBool(operation.Spec.Operation == "convertToSnapshotStandby")
+	if err := r.Patch(ctx, &sidb, patch); err != nil {
+		return r.completeOperation(operation, "", err, ctx)
+	}
+
+	operation.Status.Message = fmt.Sprintf("waiting for singleinstancedatabase %s to convert", sidb.Name)
+	r.Status().Update(ctx, operation)
+	return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+}
+
+// #############################################################################################################################
+//
+//	Check the role of a singleinstancedatabase converted by its controller
+//
+// #############################################################################################################################
+func (r *DataguardOperationReconciler) checkConversion(operation *dbapi.DataguardOperation, broker *dbapi.DataguardBroker, targetSid string,
+	ctx context.Context) (ctrl.Result, error) {
+
+	var sidb dbapi.SingleInstanceDatabase
+	if err := r.Get(ctx, types.NamespacedName{Namespace: broker.Namespace, Name: broker.Status.DatabasesInDataguardConfig[targetSid]}, &sidb); err != nil {
+		return r.completeOperation(operation, "", err, ctx)
+	}
+
+	toSnapshot := operation.Spec.Operation == "convertToSnapshotStandby"
+	expectedRole := "PHYSICAL_STANDBY"
+	if toSnapshot {
+		expectedRole = "SNAPSHOT_STANDBY"
+	}
+	if sidb.Status.ConvertToSnapshotStandby == toSnapshot && sidb.Status.Role == expectedRole && sidb.Status.Status == dbcommons.StatusReady {
+		return r.completeOperation(operation, fmt.Sprintf("database %s role is %s", targetSid, sidb.Status.Role), nil, ctx)
+	}
+	if operation.Status.StartTime != nil && time.Since(operation.Status.StartTime.Time) > dataguardOperationConvertTimeout {
+		return r.completeOperation(operation, "", fmt.Errorf("database %s was not converted to %s, check the events of singleinstancedatabase %s",
+			targetSid, expectedRole, sidb.Name), ctx)
+	}
+	return ctrl.Result{Requeue: true, RequeueAfter: 30 * time.Second}, nil
+}
+
+// Keep the end of the DGMGRL output, where the outcome of the command is reported
+func truncateOperationOutput(out string) string {
+	out = strings.TrimSpace(out)
+	if len(out) > dataguardOperationOutputLimit {
+		return out[len(out)-dataguardOperationOutputLimit:]
+	}
+	return out
+}
+
+// #############################################################################################################################
+//
+//	Setup the controller with the Manager
+//
+// #############################################################################################################################
+func (r *DataguardOperationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.brokerRecorder = mgr.GetEventRecorderFor("DataguardBroker")
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&dbapi.DataguardOperation{}).
+		WithEventFilter(dbcommons.ResourceEventHandler()).
+		// Operations are reconciled one at a time, so that two operations never run on a dataguard configuration at once
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(r)
+}
@@ -190,6 +190,26 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "SingleInstanceDatabase")
 		os.Exit(1)
 	}
+	if err = (&databasecontroller.SingleInstanceDatabaseBackupReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("database").WithName("SingleInstanceDatabaseBackup"),
+		Scheme:   mgr.GetScheme(),
+		Config:   mgr.GetConfig(),
+		Recorder: mgr.GetEventRecorderFor("SingleInstanceDatabaseBackup"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SingleInstanceDatabaseBackup")
+		os.Exit(1)
+	}
+	if err = (&databasecontroller.DatabaseUserReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("database").WithName("DatabaseUser"),
+		Scheme:   mgr.GetScheme(),
+		Config:   mgr.GetConfig(),
+		Recorder: mgr.GetEventRecorderFor("DatabaseUser"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DatabaseUser")
+		os.Exit(1)
+	}
 	if err = (&databasecontroller.ShardingDatabaseReconciler{
 		Client:   mgr.GetClient(),
 		Log:      ctrl.Log.WithName("controllers").WithName("database").WithName("ShardingDatabase"),
@@ -395,11 +415,21 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "DataguardBroker")
 		os.Exit(1)
 	}
+	if err = (&dataguardcontroller.DataguardOperationReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("dataguard").WithName("DataguardOperation"),
+		Scheme:   mgr.GetScheme(),
+		Config:   mgr.GetConfig(),
+		Recorder: mgr.GetEventRecorderFor("DataguardOperation"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DataguardOperation")
+		os.Exit(1)
+	}
 
 	if err = (&databasecontroller.OrdsSrvsReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		// Config:   mgr.GetConfig(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Config:   mgr.GetConfig(),
 		Recorder: mgr.GetEventRecorderFor("OrdsSrvs"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OrdsSrvs")
@@ -378,10 +378,7 @@ set_ords_secret() {
 }
 
 #------------------------------------------------------------------------------
-setup_credentials(){
-		
-
-		sub "Setup Credentials"
+read_credentials(){
 
 		# reading users from env
 		for key in dbusername dbadminuser dbcdbadminuser dbconnectiontype; do
@@ -397,7 +394,8 @@ setup_credentials(){
                 var_key="${pool_name_underscore}_${key}"
                 echo "Obtaining value from initContainer variable: ${var_key}"
                 var_val="${!var_key}"
-				if [[ (-n "${var_val}") && (-n "${ENC_PRV_KEY}") && (-f "${ENC_PRV_KEY_FILE}") ]]; then 
+				vault_key="${var_key}_vault"
+				if [[ (-n "${var_val}") && (-n "${ENC_PRV_KEY}") && (-f "${ENC_PRV_KEY_FILE}") && ("${!vault_key}" != "true") ]]; then 
 				  echo "Decrypting ${var_key}"
 				  cd /opt/oracle/ords/scripts || return
 				  var_val_enc=${var_val}
@@ -408,6 +406,15 @@ setup_credentials(){
                   config[${key}]="${var_val}"
 				fi
         done
+}
+
+#------------------------------------------------------------------------------
+setup_credentials(){
+		
+
+		sub "Setup Credentials"
+
+		read_credentials
 
         # Set ORDS Secrets
 		echo "Saving ORDS credentials"
@@ -821,10 +828,67 @@ pool_check(){
 	echo "SQL check SUCCESS"
 }
 
+#------------------------------------------------------------------------------
+# Pool health, one POOLSTATUS line per pool is read by the controller
+# POOLSTATUS|<pool>|OK|<ords version>|<apex version>|
+# POOLSTATUS|<pool>|ERROR|||<error>
+pool_status(){
+	local -r _log=$(mktemp)
+	local _ords_version=""
+	local _apex_version=""
+	local _error=""
+
+	pool_check > "${_log}" 2>&1
+	if (( $? > 0 )); then
+		_error=$(grep -m1 -E "ORA-|SQLERROR|Unable" "${_log}")
+		rm -f "${_log}"
+		echo "POOLSTATUS|${pool_name}|ERROR|||${_error:-SQL check FAILED}"
+		return 1
+	fi
+	rm -f "${_log}"
+
+	# pool_check succeeds without testing a pool that has no connect string
+	if [[ -z "${config[connect]}" ]]; then
+		echo "POOLSTATUS|${pool_name}|UNCHECKED|||Unable to get database connect string, pool not checked"
+		return 0
+	fi
+
+	run_sql "SELECT ORDS.INSTALLED_VERSION FROM DUAL;" _ords_version > /dev/null 2>&1 || _ords_version=""
+	run_sql "SELECT VERSION_NO FROM APEX_RELEASE;" _apex_version > /dev/null 2>&1 || _apex_version="NotInstalled"
+	_ords_version=${_ords_version//[^0-9.]/}
+	[[ ${_apex_version} != "NotInstalled" ]] && _apex_version=${_apex_version//[^0-9.]/}
+
+	echo "POOLSTATUS|${pool_name}|OK|${_ords_version}|${_apex_version}|"
+}
+
+pool_status_all(){
+	[[ -d "${ORDS_CONFIG}/databases/" ]] || return 0
+
+	for pool in "${ORDS_CONFIG}/databases/"*; do
+		pool_name=$(basename "$pool")
+		pool_name_underscore=${pool_name//-/_}
+		ords_cfg_cmd="ords --config $ORDS_CONFIG config --db-pool ${pool_name}"
+		declare -A config=()
+
+		pool_parameters > /dev/null 2>&1
+		read_credentials > /dev/null 2>&1
+		setup_sql_environment > /dev/null 2>&1
+		pool_status
+	done
+}
+
 #------------------------------------------------------------------------------
 # INIT
 #------------------------------------------------------------------------------
 declare -A pool_exit
+
+# Pool health check, run by the controller in the ORDS container
+if [[ "${1-}" == "--pool-status" ]]; then
+	global_parameters > /dev/null 2>&1
+	pool_status_all
+	exit 0
+fi
+
 sep
 sub "ORDSSRVS init"
 sep